@@ -0,0 +1,150 @@
+// actionmap.go
+package tinytui
+
+import (
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Named actions resolved by the built-in components. Components look the key up in their ActionMap
+// and run the resulting action, so remapping an action changes every component that uses it.
+const (
+	ActionUp        = "up"         // Move up one row (Grid)
+	ActionDown      = "down"       // Move down one row (Grid)
+	ActionLeft      = "left"       // Move left (Grid column, ButtonRow button, Text horizontal scroll)
+	ActionRight     = "right"      // Move right (Grid column, ButtonRow button, Text horizontal scroll)
+	ActionLineStart = "line-start" // Move to the first column (Grid)
+	ActionLineEnd   = "line-end"   // Move to the last column (Grid)
+	ActionPageUp    = "page-up"    // Move up one page (Grid)
+	ActionPageDown  = "page-down"  // Move down one page (Grid)
+)
+
+// ActionMap maps keys to named actions. Non-rune keys are bound as KeyModCombo values (compared
+// exactly, like KeyModCombo.Matches); rune keys such as vim-style "hjkl" are bound separately and
+// match regardless of Shift. Each key resolves to at most one action; an action may have several keys.
+// An ActionMap is safe for concurrent use.
+type ActionMap struct {
+	mu    sync.RWMutex
+	keys  map[KeyModCombo]string // Non-rune key bindings
+	runes map[rune]string        // Rune key bindings
+}
+
+// defaultActionMap is the global map used by components without their own.
+var defaultActionMap = newDefaultActionMap()
+
+// NewActionMap creates an empty ActionMap.
+func NewActionMap() *ActionMap {
+	return &ActionMap{
+		keys:  make(map[KeyModCombo]string),
+		runes: make(map[rune]string),
+	}
+}
+
+// newDefaultActionMap creates the map holding the built-in bindings: arrows and vim-style
+// h/j/k/l, Home/End, and PgUp/PgDn. The navigation keys are also bound with Shift held, as
+// components matched them regardless of Shift before key combos were compared exactly.
+func newDefaultActionMap() *ActionMap {
+	m := NewActionMap()
+	for _, mod := range []tcell.ModMask{tcell.ModNone, tcell.ModShift} {
+		m.AddActionBinding(ActionUp, KeyModCombo{Key: tcell.KeyUp, Mod: mod})
+		m.AddActionBinding(ActionDown, KeyModCombo{Key: tcell.KeyDown, Mod: mod})
+		m.AddActionBinding(ActionLeft, KeyModCombo{Key: tcell.KeyLeft, Mod: mod})
+		m.AddActionBinding(ActionRight, KeyModCombo{Key: tcell.KeyRight, Mod: mod})
+		m.AddActionBinding(ActionLineStart, KeyModCombo{Key: tcell.KeyHome, Mod: mod})
+		m.AddActionBinding(ActionLineEnd, KeyModCombo{Key: tcell.KeyEnd, Mod: mod})
+		m.AddActionBinding(ActionPageUp, KeyModCombo{Key: tcell.KeyPgUp, Mod: mod})
+		m.AddActionBinding(ActionPageDown, KeyModCombo{Key: tcell.KeyPgDn, Mod: mod})
+	}
+	m.AddActionRune(ActionUp, 'k')
+	m.AddActionRune(ActionDown, 'j')
+	m.AddActionRune(ActionLeft, 'h')
+	m.AddActionRune(ActionRight, 'l')
+	return m
+}
+
+// DefaultActionMap returns the global ActionMap shared by every component that has no map of its own.
+// Changing it remaps those components at once, e.g.
+// DefaultActionMap().SetActionBinding(ActionPageDown, KeyModCombo{Key: tcell.KeyCtrlF, Mod: tcell.ModCtrl}).
+func DefaultActionMap() *ActionMap {
+	return defaultActionMap
+}
+
+// Clone returns an independent copy of the map.
+func (m *ActionMap) Clone() *ActionMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	c := NewActionMap()
+	for combo, action := range m.keys {
+		c.keys[combo] = action
+	}
+	for r, action := range m.runes {
+		c.runes[r] = action
+	}
+	return c
+}
+
+// SetActionBinding remaps action to combo alone: every other key and rune bound to action is unbound.
+// Use AddActionBinding to keep the existing keys.
+func (m *ActionMap) SetActionBinding(action string, combo KeyModCombo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.unbindLocked(action)
+	m.keys[combo] = action
+}
+
+// AddActionBinding binds combo to action in addition to the action's existing keys.
+// A combo bound to another action is taken over.
+func (m *ActionMap) AddActionBinding(action string, combo KeyModCombo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys[combo] = action
+}
+
+// AddActionRune binds the rune key r to action in addition to the action's existing keys.
+// A rune bound to another action is taken over.
+func (m *ActionMap) AddActionRune(action string, r rune) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.runes[r] = action
+}
+
+// RemoveAction unbinds every key and rune bound to action.
+func (m *ActionMap) RemoveAction(action string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.unbindLocked(action)
+}
+
+// unbindLocked removes all bindings of action. The caller must hold the write lock.
+func (m *ActionMap) unbindLocked(action string) {
+	for combo, bound := range m.keys {
+		if bound == action {
+			delete(m.keys, combo)
+		}
+	}
+	for r, bound := range m.runes {
+		if bound == action {
+			delete(m.runes, r)
+		}
+	}
+}
+
+// Resolve returns the action bound to the key event, or "" if the key is unbound.
+// Rune keys match only without Ctrl, Alt or Meta, so they don't shadow application shortcuts.
+func (m *ActionMap) Resolve(ev *tcell.EventKey) string {
+	if ev == nil {
+		return ""
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if ev.Key() == tcell.KeyRune {
+		if ev.Modifiers()&^tcell.ModShift != 0 {
+			return ""
+		}
+		return m.runes[ev.Rune()]
+	}
+	return m.keys[KeyModCombo{Key: ev.Key(), Mod: ev.Modifiers()}]
+}
\ No newline at end of file
@@ -0,0 +1,42 @@
+// actionmap_test.go
+package tinytui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestDefaultActionMapShiftNavigation(t *testing.T) {
+	tests := []struct {
+		key  tcell.Key
+		mod  tcell.ModMask
+		want string
+	}{
+		{tcell.KeyDown, tcell.ModNone, ActionDown},
+		{tcell.KeyDown, tcell.ModShift, ActionDown},
+		{tcell.KeyRight, tcell.ModShift, ActionRight},
+		{tcell.KeyEnd, tcell.ModShift, ActionLineEnd},
+		{tcell.KeyPgDn, tcell.ModShift, ActionPageDown},
+		{tcell.KeyDown, tcell.ModCtrl | tcell.ModShift, ""}, // Pane resizing, not navigation
+	}
+	for _, tt := range tests {
+		ev := tcell.NewEventKey(tt.key, 0, tt.mod)
+		if got := DefaultActionMap().Resolve(ev); got != tt.want {
+			t.Errorf("%v resolved to %q, want %q", KeyModCombo{Key: tt.key, Mod: tt.mod}, got, tt.want)
+		}
+	}
+}
+
+func TestGridShiftArrowMovesSelection(t *testing.T) {
+	grid := NewGrid()
+	grid.SetCells([][]string{{"a", "b"}, {"c", "d"}})
+	grid.SetRect(0, 0, 20, 5)
+	grid.Focus()
+
+	grid.HandleEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModShift))
+	grid.HandleEvent(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModShift))
+	if row, col, _ := grid.GetSelectedCell(); row != 1 || col != 1 {
+		t.Errorf("selection at %d,%d after Shift+Down, Shift+Right; want 1,1", row, col)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,126 @@
+// animation.go
+package tinytui
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// paneAnimation tracks an in-progress show animation for a Pane.
+type paneAnimation struct {
+	kind     Animation     // Direction the content slides in from
+	start    time.Time     // When the animation started
+	duration time.Duration // Total animation length
+}
+
+// progress returns how far the animation has run, from 0.0 (start) to 1.0 (finished).
+func (a *paneAnimation) progress(now time.Time) float64 {
+	if a.duration <= 0 {
+		return 1
+	}
+	p := float64(now.Sub(a.start)) / float64(a.duration)
+	if p > 1 {
+		return 1
+	}
+	if p < 0 {
+		return 0
+	}
+	return p
+}
+
+// AnimateShow plays a short slide-in of the pane's contents over the given duration,
+// driven by the application's main-loop timers. The pane settles at its normal rect when done.
+// Falls back to an instant show if the pane isn't attached to an application,
+// animations are disabled (Application.SetAnimationsEnabled) or the duration is not positive.
+func (p *Pane) AnimateShow(kind Animation, duration time.Duration) {
+	if p.app == nil || !p.app.AnimationsEnabled() || duration <= 0 {
+		p.anim = nil
+		p.dirty = true
+		return
+	}
+	p.anim = &paneAnimation{kind: kind, start: time.Now(), duration: duration}
+	p.dirty = true
+	p.scheduleAnimationFrame(p.anim)
+}
+
+// IsAnimating reports whether a show animation is currently running on the pane.
+func (p *Pane) IsAnimating() bool {
+	return p.anim != nil
+}
+
+// scheduleAnimationFrame requests the next animation frame at the application's frame rate.
+// Frames stop once the animation finishes or is replaced by a newer one.
+func (p *Pane) scheduleAnimationFrame(anim *paneAnimation) {
+	frameDelay := time.Second / time.Duration(max(p.app.maxFPS, 1))
+	p.app.AfterFunc(frameDelay, func(app *Application) {
+		if p.anim != anim {
+			return // Superseded or cancelled
+		}
+		if anim.progress(time.Now()) >= 1 {
+			p.anim = nil // Settle at the final position
+		} else {
+			p.scheduleAnimationFrame(anim)
+		}
+		p.dirty = true
+		app.QueueRedraw()
+	})
+}
+
+// drawAnimationFrame shifts the pane's already-drawn cells according to the animation progress,
+// clipped to the pane's rect. Cells not yet covered by the sliding content are left blank
+// in the pane's background style.
+func (p *Pane) drawAnimationFrame(screen tcell.Screen) {
+	anim := p.anim
+	rect := p.rect
+	remaining := 1 - anim.progress(time.Now())
+	if remaining <= 0 || rect.Width <= 0 || rect.Height <= 0 {
+		return
+	}
+
+	// Offset of the content from its final position
+	dx, dy := 0, 0
+	switch anim.kind {
+	case AnimSlideLeft:
+		dx = int(float64(rect.Width) * remaining)
+	case AnimSlideRight:
+		dx = -int(float64(rect.Width) * remaining)
+	case AnimSlideUp:
+		dy = int(float64(rect.Height) * remaining)
+	case AnimSlideDown:
+		dy = -int(float64(rect.Height) * remaining)
+	}
+	if dx == 0 && dy == 0 {
+		return
+	}
+
+	// Capture the fully drawn pane, then redraw it shifted
+	type capturedCell struct {
+		mainc rune
+		combc []rune
+		style tcell.Style
+	}
+	captured := make([]capturedCell, rect.Width*rect.Height)
+	for row := 0; row < rect.Height; row++ {
+		for col := 0; col < rect.Width; col++ {
+			mainc, combc, style, _ := screen.GetContent(rect.X+col, rect.Y+row)
+			captured[row*rect.Width+col] = capturedCell{mainc: mainc, combc: combc, style: style}
+		}
+	}
+
+	Fill(screen, rect.X, rect.Y, rect.Width, rect.Height, ' ', p.style)
+	for row := 0; row < rect.Height; row++ {
+		targetRow := row + dy
+		if targetRow < 0 || targetRow >= rect.Height {
+			continue
+		}
+		for col := 0; col < rect.Width; col++ {
+			targetCol := col + dx
+			if targetCol < 0 || targetCol >= rect.Width {
+				continue
+			}
+			cell := captured[row*rect.Width+col]
+			screen.SetContent(rect.X+targetCol, rect.Y+targetRow, cell.mainc, cell.combc, cell.style)
+		}
+	}
+}
\ No newline at end of file
@@ -4,6 +4,7 @@ package tinytui
 import (
 	"fmt" // Import fmt for error formatting
 	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
 	"os"
 	"os/signal"
 	"syscall"
@@ -18,6 +19,7 @@ type Application struct {
 
 	// Focus management
 	focusedComponent Component
+	focusOrder       []Component // Focusable components in cycle order when focus was last set (to recover from removals)
 
 	// Event management
 	eventChan  chan tcell.Event
@@ -27,9 +29,15 @@ type Application struct {
 
 	// Configuration
 	theme             Theme
+	onThemeChange     func(theme Theme) // Called after a new theme has been applied to the component tree
+	focusRing         *bool             // Focus ring override (nil = follow the theme's FocusRingEnabled)
+	dimInactive       bool              // Dim root panes that don't contain the focused component?
 	showPaneIndices   bool
+	showNonNavMarker  bool // Mark root panes without a navigation index with "[-]"?
 	screenMode        ScreenMode
 	clearScreenOnExit bool
+	escapeAction      EscapeAction           // What an unhandled Escape does (default EscapeQuit)
+	onEscape          func(app *Application) // Called for unhandled Escape with EscapeCustom
 
 	// Keybindings
 	keyHandlers  map[KeyModCombo]KeyHandler   // Handlers for specific key+modifier combos
@@ -38,8 +46,56 @@ type Application struct {
 	// Performance
 	maxFPS     int          // Maximum redraw rate
 	frameTimer *time.Ticker // Ticker for enforcing maxFPS redraw checks
+	renderMode RenderMode   // Fixed-tick polling or on-demand redraws
+
+	// Size override
+	forcedWidth  int // Layout width set by Resize (0 = use the screen size)
+	forcedHeight int // Layout height set by Resize (0 = use the screen size)
+
+	// Pane resizing
+	resizeMode    bool         // Is keyboard pane-resize mode active (plain arrows resize)?
+	resizeModeKey *KeyModCombo // Key combination that toggles resize mode (nil = unbound)
+
+	// Animation
+	animationsEnabled bool // Are opt-in animations (e.g., Pane.AnimateShow) played?
+
+	// Forms
+	formFields []FormField // Fields tracked by SetFormBaseline / IsFormDirty
+
+	// Clipboard
+	clipboard    string // Text most recently copied by a component (see SetClipboard)
+	pasteEnabled bool   // Is bracketed paste requested from the terminal?
+	pasting      bool   // Is a bracketed paste in progress (between its start and end events)?
+
+	// Key prompt
+	prompt *keyPrompt // Pending PromptKey request (nil if none)
+
+	// Mouse (see mouse.go)
+	mouseEnabled bool      // Are mouse events requested from the terminal?
+	mouseCapture Component // Component that received the last button press, until release
+
+	// Event recording (see macro.go)
+	recording bool          // Are processed key/mouse events being recorded?
+	recorded  []tcell.Event // Events recorded since StartRecording
+
+	// Debugging
+	debugDrawRegions bool           // Tint the regions repainted because they were dirty, for one frame
+	inspector        inspectorState // Developer inspector overlay (see SetInspectorKey)
+
+	// Idle detection
+	idleTimeout time.Duration          // Time without key/mouse input before onIdle fires (0 = disabled)
+	onIdle      func(app *Application) // Called on the main loop once the idle timeout elapses
+	onActive    func(app *Application) // Called on the main loop at the first input after going idle
+	idleTimer   *time.Timer            // Pending idle timeout (nil if none)
+	idle        bool                   // Has onIdle fired without input since?
 }
 
+// debugRegionTint is the background color used to mark repainted regions when SetDebugDrawRegions is on.
+const debugRegionTint = tcell.ColorMaroon
+
+// debugRegionDuration is how long repainted regions stay tinted before a clean frame is drawn.
+const debugRegionDuration = 150 * time.Millisecond
+
 // NewApplication creates a new application with default settings.
 // Initializes the theme from the current global theme.
 func NewApplication() *Application {
@@ -64,6 +120,7 @@ func NewApplication() *Application {
 		clearScreenOnExit: true,
 		theme:             GetTheme(), // Initialize with the globally set theme
 		maxFPS:            60,         // Default FPS
+		animationsEnabled: true,
 	}
 	return app
 }
@@ -89,6 +146,64 @@ func (app *Application) notifyThemeChange(theme Theme) {
 		// Start recursive theme application from the root layout
 		app.layout.ApplyThemeRecursively(theme)
 	}
+	// Let application code restyle anything the tree walk cannot reach (e.g., custom components)
+	if app.onThemeChange != nil {
+		app.onThemeChange(theme)
+	}
+}
+
+// SetFocusRing overrides the theme's FocusRingEnabled setting: when enabled, a highlighted outline
+// (the theme's FocusRingStyle) is drawn on the cells surrounding the focused component after all
+// components have rendered, typically recoloring the enclosing pane border around it.
+func (app *Application) SetFocusRing(enabled bool) {
+	app.focusRing = &enabled
+	app.QueueRedraw()
+}
+
+// focusRingEnabled reports whether the focus ring is drawn (override first, then the theme).
+func (app *Application) focusRingEnabled() bool {
+	if app.focusRing != nil {
+		return *app.focusRing
+	}
+	return app.GetTheme().FocusRingEnabled()
+}
+
+// drawFocusRing restyles the cells immediately surrounding the focused component's rectangle,
+// clipped to the screen. The runes already drawn there (usually the pane border) are kept.
+func (app *Application) drawFocusRing() {
+	focused := app.GetFocusedComponent()
+	if focused == nil || !app.focusRingEnabled() {
+		return
+	}
+	x, y, width, height := focused.GetRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	ringStyle := app.GetTheme().FocusRingStyle().ToTcell()
+	screenWidth, screenHeight := app.screen.Size()
+	restyle := func(px, py int) {
+		if px < 0 || py < 0 || px >= screenWidth || py >= screenHeight {
+			return
+		}
+		mainc, combc, _, _ := app.screen.GetContent(px, py)
+		app.screen.SetContent(px, py, mainc, combc, ringStyle)
+	}
+	for px := x - 1; px <= x+width; px++ {
+		restyle(px, y-1)      // Top edge
+		restyle(px, y+height) // Bottom edge
+	}
+	for py := y; py < y+height; py++ {
+		restyle(x-1, py)     // Left edge
+		restyle(x+width, py) // Right edge
+	}
+}
+
+// SetOnThemeChange sets a callback fired by SetTheme after the new theme has been propagated to
+// all panes and ThemedComponents. Use it to restyle custom components that do not implement
+// ThemedComponent (which only get marked dirty) or any other theme-dependent state.
+func (app *Application) SetOnThemeChange(handler func(theme Theme)) {
+	app.onThemeChange = handler
 }
 
 // GetTheme returns the application's current theme.
@@ -146,6 +261,30 @@ func (app *Application) IsShowPaneIndicesEnabled() bool {
 	return app.showPaneIndices
 }
 
+// SetShowNonNavigablePaneMarker sets whether root-layout panes without a navigation index (no focusable
+// child, so Alt+Number skips them) show a "[-]" marker in their border. A debugging aid; the marker
+// only appears while pane indices are shown.
+func (app *Application) SetShowNonNavigablePaneMarker(show bool) {
+	if app.showNonNavMarker != show {
+		app.showNonNavMarker = show
+		app.QueueRedraw()
+	}
+}
+
+// showsNonNavigableMarker reports whether pane p should draw the non-navigable marker: the marker
+// and pane indices are enabled, and p is an active root-layout pane without a navigation index.
+func (app *Application) showsNonNavigableMarker(p *Pane) bool {
+	if !app.showNonNavMarker || !app.showPaneIndices || app.layout == nil || p.GetNavIndex() > 0 {
+		return false
+	}
+	for i := range app.layout.panes {
+		if app.layout.panes[i].Active && app.layout.panes[i].Pane == p {
+			return true
+		}
+	}
+	return false // Nested panes never get navigation indices
+}
+
 // SetScreenMode sets the desired screen mode (Normal, Fullscreen, Alternate).
 func (app *Application) SetScreenMode(mode ScreenMode) {
 	if app.screenMode == mode {
@@ -160,6 +299,209 @@ func (app *Application) SetScreenMode(mode ScreenMode) {
 	}
 }
 
+// SetAnimationsEnabled sets whether opt-in animations such as Pane.AnimateShow are played.
+// When disabled, animated operations complete instantly.
+func (app *Application) SetAnimationsEnabled(enabled bool) {
+	app.animationsEnabled = enabled
+}
+
+// AnimationsEnabled returns whether opt-in animations are played.
+func (app *Application) AnimationsEnabled() bool {
+	return app.animationsEnabled
+}
+
+// SetDebugDrawRegions enables a developer overlay that tints the regions repainted in each frame
+// because a pane or component inside them was dirty. The tint is cleared by a follow-up frame shortly
+// after, so over-invalidation (large areas repainting for small changes) is easy to spot.
+func (app *Application) SetDebugDrawRegions(enabled bool) {
+	if app.debugDrawRegions != enabled {
+		app.debugDrawRegions = enabled
+		app.QueueRedraw()
+	}
+}
+
+// SetIdleTimeout calls onIdle after d passes without any key or mouse input (e.g., to dim the
+// screen, show a clock or lock a kiosk UI), and onActive at the first input after that.
+// The countdown restarts on every key or mouse event. Both callbacks run on the main loop;
+// either may be nil. A duration of 0 or less disables idle detection.
+func (app *Application) SetIdleTimeout(d time.Duration, onIdle, onActive func(app *Application)) {
+	app.idleTimeout = d
+	app.onIdle = onIdle
+	app.onActive = onActive
+	app.idle = false
+	app.restartIdleTimer()
+}
+
+// restartIdleTimer cancels any pending idle timeout and starts a new one if idle detection is enabled.
+func (app *Application) restartIdleTimer() {
+	if app.idleTimer != nil {
+		app.idleTimer.Stop()
+		app.idleTimer = nil
+	}
+	if app.idleTimeout <= 0 {
+		return
+	}
+	var timer *time.Timer
+	timer = app.AfterFunc(app.idleTimeout, func(app *Application) {
+		if app.idleTimer != timer {
+			return // Superseded by input (or a new timeout) while the command was queued
+		}
+		app.idleTimer = nil
+		app.idle = true
+		if app.onIdle != nil {
+			app.onIdle(app)
+		}
+	})
+	app.idleTimer = timer
+}
+
+// noteActivity records user input: it ends the idle state (calling onActive) and restarts the countdown.
+func (app *Application) noteActivity() {
+	if app.idleTimeout <= 0 {
+		return
+	}
+	if app.idle {
+		app.idle = false
+		if app.onActive != nil {
+			app.onActive(app)
+		}
+	}
+	app.restartIdleTimer()
+}
+
+// SetClipboard stores text on the application clipboard, used by component copy/cut/paste
+// (e.g., Grid with SetClipboardEnabled). The text is also offered to the terminal's system
+// clipboard where supported (OSC 52).
+func (app *Application) SetClipboard(text string) {
+	app.clipboard = text
+	if app.screen != nil {
+		app.screen.SetClipboard([]byte(text))
+	}
+}
+
+// Clipboard returns the text most recently stored with SetClipboard.
+func (app *Application) Clipboard() string {
+	return app.clipboard
+}
+
+// SetPasteEnabled turns bracketed paste on or off (off by default). While enabled, text pasted
+// into the terminal is delivered to the focused component between paste start and end events
+// (*tcell.EventPaste), so it can be inserted in one batch (e.g., by TextInput); the pasted keys
+// never reach global key handlers. Can be called before or after Run.
+func (app *Application) SetPasteEnabled(enabled bool) {
+	app.pasteEnabled = enabled
+	if app.screen == nil {
+		return // Applied by Run
+	}
+	if enabled {
+		app.screen.EnablePaste()
+	} else {
+		app.screen.DisablePaste()
+		app.pasting = false
+	}
+}
+
+// IsPasteEnabled returns whether bracketed paste is enabled.
+func (app *Application) IsPasteEnabled() bool {
+	return app.pasteEnabled
+}
+
+// keyPrompt is a pending PromptKey request: a status message and the handler for the next key.
+type keyPrompt struct {
+	message string                     // Shown on the bottom line of the screen while waiting
+	handler func(*tcell.EventKey) bool // Receives the next key; returns false to keep waiting
+}
+
+// PromptKey shows message on the bottom line of the screen and routes the next key event to
+// handler instead of normal processing (e.g., "Press any key to continue", "Delete? (y/n)", or
+// the key after a leader key). The prompt ends when handler returns true; returning false ignores
+// the key and keeps waiting. Escape cancels the prompt without calling handler, and Ctrl+C still
+// quits, even if the focused component would otherwise take it for copying. A new PromptKey call
+// replaces a pending one.
+func (app *Application) PromptKey(message string, handler func(ev *tcell.EventKey) bool) {
+	if handler == nil {
+		return
+	}
+	app.prompt = &keyPrompt{message: message, handler: handler}
+	app.QueueRedraw()
+}
+
+// CancelPrompt ends a pending PromptKey without calling its handler.
+func (app *Application) CancelPrompt() {
+	if app.prompt != nil {
+		app.prompt = nil
+		app.QueueRedraw()
+	}
+}
+
+// IsPrompting reports whether a PromptKey request is waiting for a key.
+func (app *Application) IsPrompting() bool {
+	return app.prompt != nil
+}
+
+// ConfirmAction asks a yes/no question on the prompt line (see PromptKey) and calls onConfirm only
+// if the answer is yes: 'y' or Enter confirms, 'n' or Escape cancels. Other keys are ignored until
+// the question is answered, so nothing else receives keyboard input in the meantime. Use it to
+// guard destructive actions, e.g. app.ConfirmAction("Delete selected rows?", deleteRows).
+func (app *Application) ConfirmAction(prompt string, onConfirm func()) {
+	app.PromptKey(prompt+" [y/n]", func(ev *tcell.EventKey) bool {
+		switch {
+		case ev.Key() == tcell.KeyEnter, ev.Key() == tcell.KeyRune && (ev.Rune() == 'y' || ev.Rune() == 'Y'):
+			if onConfirm != nil {
+				onConfirm()
+			}
+			return true
+		case ev.Key() == tcell.KeyRune && (ev.Rune() == 'n' || ev.Rune() == 'N'):
+			return true // Declined
+		}
+		return false // Keep waiting for an answer
+	})
+}
+
+// handlePromptKey routes a key event to the pending prompt, if any. Returns true if the event was consumed.
+func (app *Application) handlePromptKey(ev *tcell.EventKey) bool {
+	prompt := app.prompt
+	if prompt == nil {
+		return false
+	}
+	if ev.Key() == tcell.KeyEscape {
+		app.CancelPrompt()
+		return true
+	}
+	if prompt.handler(ev) && app.prompt == prompt { // Handler may have started a new prompt
+		app.CancelPrompt()
+	}
+	return true
+}
+
+// drawPrompt draws the pending prompt message across the bottom line of the screen.
+func (app *Application) drawPrompt() {
+	if app.prompt == nil {
+		return
+	}
+	width, height := app.layoutSize()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	if app.cursorMgr != nil {
+		app.cursorMgr.ResetForFrame() // Keys go to the prompt, so hide any input cursor
+	}
+	style := app.GetTheme().TextSelectedStyle()
+	Fill(app.screen, 0, height-1, width, 1, ' ', style)
+	DrawText(app.screen, 0, height-1, style, runewidth.Truncate(app.prompt.message, width, "…"))
+}
+
+// copyHandler is implemented by components that may claim Ctrl+C for copying instead of quitting.
+type copyHandler interface {
+	HandlesCopy() bool
+}
+
+// componentHandlesCopy reports whether Ctrl+C should go to comp rather than stop the application.
+func componentHandlesCopy(comp Component) bool {
+	handler, ok := comp.(copyHandler)
+	return ok && handler.HandlesCopy()
+}
+
 // SetClearScreenOnExit sets whether the screen should be cleared when the application exits.
 func (app *Application) SetClearScreenOnExit(clear bool) {
 	app.clearScreenOnExit = clear
@@ -178,8 +520,64 @@ func (app *Application) SetMaxFPS(fps int) {
 	app.maxFPS = fps
 
 	// If running, reset the frame timer ticker
-	if app.frameTimer != nil {
-		app.frameTimer.Stop()
+	app.applyRenderMode()
+}
+
+// SetRenderMode selects between polling for dirty components on a fixed tick (RenderFixedTick, the
+// default) and redrawing only on demand (RenderOnDemand), which eliminates idle CPU wake-ups.
+// In on-demand mode, animations keep running as long as they schedule frames via AfterFunc,
+// Dispatch or QueueRedraw (as the built-in ones do); code that only flips a dirty flag from
+// another goroutine will not be drawn until the next event, command or redraw request.
+func (app *Application) SetRenderMode(mode RenderMode) {
+	if app.renderMode == mode {
+		return
+	}
+	app.renderMode = mode
+	app.applyRenderMode()
+	app.QueueRedraw() // Catch up on anything dirtied while the ticker was stopped
+}
+
+// SetEscapeAction sets what an Escape key press does when the focused component didn't handle it:
+// quit the application (EscapeQuit, the default), blur the focused component (EscapeBlur), or
+// call the handler set with SetOnEscape (EscapeCustom).
+func (app *Application) SetEscapeAction(action EscapeAction) {
+	app.escapeAction = action
+}
+
+// SetOnEscape sets the handler called on the main loop for unhandled Escape presses when the
+// escape action is EscapeCustom.
+func (app *Application) SetOnEscape(handler func(app *Application)) {
+	app.onEscape = handler
+}
+
+// handleEscape performs the configured escape action for an unhandled Escape key press.
+func (app *Application) handleEscape() {
+	switch app.escapeAction {
+	case EscapeBlur:
+		if app.focusedComponent != nil {
+			app.SetFocus(nil)
+		}
+	case EscapeCustom:
+		if app.onEscape != nil {
+			app.onEscape(app)
+		}
+	default:
+		app.Stop()
+	}
+}
+
+// RenderMode returns the current render mode.
+func (app *Application) RenderMode() RenderMode {
+	return app.renderMode
+}
+
+// applyRenderMode starts or stops the frame ticker to match the render mode and max FPS, if running.
+func (app *Application) applyRenderMode() {
+	if app.frameTimer == nil {
+		return
+	}
+	app.frameTimer.Stop()
+	if app.renderMode == RenderFixedTick {
 		frameDelay := time.Second / time.Duration(app.maxFPS)
 		app.frameTimer.Reset(frameDelay) // Use Reset for existing ticker
 	}
@@ -197,11 +595,6 @@ func (app *Application) Run() error {
 			return fmt.Errorf("failed to create screen: %w", err)
 		}
 
-		// Enable mouse events? Consider adding an option.
-		// if err = app.screen.EnableMouse(); err != nil {
-		// 	 return fmt.Errorf("failed to enable mouse: %w", err)
-		// }
-
 		if err = app.screen.Init(); err != nil {
 			// Attempt cleanup before returning error
 			// app.screen.Fini() // Fini might panic if Init failed partially
@@ -211,6 +604,12 @@ func (app *Application) Run() error {
 		// Apply the configured screen mode
 		app.applyScreenMode()
 	}
+	if app.mouseEnabled {
+		app.screen.EnableMouse()
+	}
+	if app.pasteEnabled {
+		app.screen.EnablePaste()
+	}
 
 	// Initialize cursor manager
 	// TODO: Allow configuring blink rate via Application option
@@ -220,6 +619,7 @@ func (app *Application) Run() error {
 	frameDelay := time.Second / time.Duration(app.maxFPS)
 	app.frameTimer = time.NewTicker(frameDelay)
 	defer app.frameTimer.Stop() // Ensure timer stops on exit
+	app.applyRenderMode()       // On-demand rendering does not tick
 
 	// Start event polling in a separate goroutine
 	eventPollDone := make(chan struct{})
@@ -264,12 +664,21 @@ func (app *Application) Run() error {
 				// Event channel closed (likely due to pollEvents stopping after screen error/finalize)
 				return fmt.Errorf("event channel closed unexpectedly") // Indicate error exit
 			}
+			// Key and mouse input counts as activity for idle detection
+			switch ev.(type) {
+			case *tcell.EventKey, *tcell.EventMouse:
+				app.noteActivity()
+			}
+
 			// Process the received terminal event
+			app.recordEvent(ev)
 			app.ProcessEvent(ev)
+			app.redrawIfDirtyOnDemand()
 
 		case cmd := <-app.cmdChan:
 			// Execute command received via Dispatch
 			cmd.Execute(app)
+			app.redrawIfDirtyOnDemand()
 
 		case <-app.redrawChan:
 			// Redraw request received (coalesced)
@@ -340,6 +749,14 @@ func (app *Application) pollEvents() {
 	}
 }
 
+// redrawIfDirtyOnDemand queues a redraw after an event or command in on-demand mode if anything
+// was left dirty, standing in for the frame tick that would otherwise have noticed it.
+func (app *Application) redrawIfDirtyOnDemand() {
+	if app.renderMode == RenderOnDemand && app.checkDirtyComponents() {
+		app.QueueRedraw()
+	}
+}
+
 // checkDirtyComponents checks if any component within the layout needs redrawing.
 func (app *Application) checkDirtyComponents() bool {
 	if app.layout == nil {
@@ -363,14 +780,31 @@ func (app *Application) draw() {
 	// Hide cursor temporarily during draw operations to avoid flicker
 	app.screen.HideCursor()
 
-	// Get current screen dimensions
-	width, height := app.screen.Size()
+	// Get current screen dimensions (or the size forced by Resize)
+	width, height := app.layoutSize()
 
 	// Update layout dimensions (triggers recalculation if size changed)
 	app.layout.SetRect(0, 0, width, height)
 
+	// Note the dirty regions before drawing (debug overlay only)
+	var dirtyRegions []Rect
+	if app.debugDrawRegions {
+		dirtyRegions = app.layout.collectDirtyRects(nil)
+	}
+
 	// Draw the layout (which recursively draws panes and components)
 	app.layout.Draw(app.screen)
+	app.dimInactivePanes()
+	app.drawFocusRing()
+	app.drawPrompt()
+	app.drawInspector()
+
+	if len(dirtyRegions) > 0 {
+		app.tintRegions(dirtyRegions)
+		app.AfterFunc(debugRegionDuration, func(app *Application) {
+			app.QueueRedraw() // Repaint without the tint
+		})
+	}
 
 	// Draw the cursor if requested by a component (e.g., TextInput) after components
 	if app.cursorMgr != nil {
@@ -385,6 +819,72 @@ func (app *Application) draw() {
 	app.layout.ClearAllDirtyFlags()
 }
 
+// SetDimInactivePanes sets whether root-layout panes that don't contain the focused component are
+// drawn dimmed, to emphasize the active pane. Dimming uses the terminal's faint attribute, which
+// lowers contrast without hiding content (terminals without support show the panes unchanged).
+func (app *Application) SetDimInactivePanes(dim bool) {
+	if app.dimInactive != dim {
+		app.dimInactive = dim
+		app.QueueRedraw()
+	}
+}
+
+// dimInactivePanes applies the faint attribute to every cell of the root panes that don't contain
+// the focused component. Nothing is dimmed while no component has focus.
+func (app *Application) dimInactivePanes() {
+	if !app.dimInactive || app.focusedComponent == nil {
+		return
+	}
+	width, height := app.screen.Size()
+	for i := range app.layout.panes {
+		slot := app.layout.panes[i]
+		if !slot.Active || slot.Pane == nil || slot.Pane.ContainsFocus(app.focusedComponent) {
+			continue
+		}
+		r := slot.Pane.rect
+		for y := max(r.Y, 0); y < min(r.Y+r.Height, height); y++ {
+			for x := max(r.X, 0); x < min(r.X+r.Width, width); x++ {
+				mainc, combc, style, _ := app.screen.GetContent(x, y)
+				app.screen.SetContent(x, y, mainc, combc, style.Dim(true))
+			}
+		}
+	}
+}
+
+// inputBlocked reports whether the component sits inside a pane whose input is disabled
+// (see Pane.SetInputEnabled), at any nesting level.
+func (app *Application) inputBlocked(comp Component) bool {
+	if app.layout == nil {
+		return false
+	}
+	for _, loc := range app.layout.findComponentPath(comp) {
+		if pane := loc.layout.panes[loc.slot].Pane; pane != nil && pane.inputDisabled {
+			return true
+		}
+	}
+	return false
+}
+
+// inspectorState holds the developer inspector overlay's settings (used by inspector.go).
+type inspectorState struct {
+	key     *KeyModCombo // Key that toggles the overlay (nil = not configured)
+	visible bool         // Is the overlay shown?
+	frames  []time.Time  // Times of the frames drawn within the last second, for the FPS count
+}
+
+// tintRegions recolors the background of every cell within the given rectangles (debug overlay).
+func (app *Application) tintRegions(regions []Rect) {
+	width, height := app.screen.Size()
+	for _, r := range regions {
+		for y := max(r.Y, 0); y < min(r.Y+r.Height, height); y++ {
+			for x := max(r.X, 0); x < min(r.X+r.Width, width); x++ {
+				mainc, combc, style, _ := app.screen.GetContent(x, y)
+				app.screen.SetContent(x, y, mainc, combc, style.Background(debugRegionTint))
+			}
+		}
+	}
+}
+
 // shutdown cleans up resources and restores the terminal. Called on normal exit.
 func (app *Application) shutdown() error {
 	// Stop timers and managers first
@@ -392,6 +892,10 @@ func (app *Application) shutdown() error {
 		app.frameTimer.Stop()
 		app.frameTimer = nil
 	}
+	if app.idleTimer != nil {
+		app.idleTimer.Stop()
+		app.idleTimer = nil
+	}
 	if app.cursorMgr != nil {
 		app.cursorMgr.Stop()
 		app.cursorMgr = nil
@@ -466,6 +970,15 @@ func (app *Application) Dispatch(cmd Command) {
 	}
 }
 
+// AfterFunc schedules fn to run on the main event loop once the duration has elapsed.
+// The returned timer can be stopped to cancel the call if it has not fired yet.
+// Use this instead of raw timers so delayed work touches UI state only from the main loop.
+func (app *Application) AfterFunc(d time.Duration, fn func(app *Application)) *time.Timer {
+	return time.AfterFunc(d, func() {
+		app.Dispatch(&SimpleCommand{Func: fn})
+	})
+}
+
 // SetFocus changes the focused component, handling blur/focus events.
 func (app *Application) SetFocus(component Component) {
 	// Don't focus nil, non-focusable, or invisible components
@@ -487,6 +1000,12 @@ func (app *Application) SetFocus(component Component) {
 	// Set the new focused component (can be nil)
 	app.focusedComponent = component
 
+	// Remember the focus order around the new focus, so cycling can find its neighbours
+	// even if the component is removed or hidden later
+	if component != nil && app.layout != nil {
+		app.focusOrder = app.layout.GetAllFocusableComponents()
+	}
+
 	// Focus the new component (if not nil)
 	if component != nil {
 		component.Focus()
@@ -501,14 +1020,101 @@ func (app *Application) GetFocusedComponent() Component {
 	return app.focusedComponent
 }
 
+// RegisterFormField adds an input component to the set tracked by SetFormBaseline and IsFormDirty.
+// Registering the same field twice has no effect.
+func (app *Application) RegisterFormField(field FormField) {
+	if field == nil {
+		return
+	}
+	for _, existing := range app.formFields {
+		if existing == field {
+			return
+		}
+	}
+	app.formFields = append(app.formFields, field)
+}
+
+// UnregisterFormField removes a field from form tracking.
+func (app *Application) UnregisterFormField(field FormField) {
+	for i, existing := range app.formFields {
+		if existing == field {
+			app.formFields = append(app.formFields[:i], app.formFields[i+1:]...)
+			return
+		}
+	}
+}
+
+// SetFormBaseline records the current values of all registered form fields as unmodified,
+// e.g., right after loading settings or after saving.
+func (app *Application) SetFormBaseline() {
+	for _, field := range app.formFields {
+		field.SetBaseline()
+	}
+}
+
+// IsFormDirty reports whether any registered form field differs from its baseline.
+func (app *Application) IsFormDirty() bool {
+	for _, field := range app.formFields {
+		if field.IsModified() {
+			return true
+		}
+	}
+	return false
+}
+
+// FocusedKeyHints returns the key hints of the focused component, for rendering
+// context-sensitive help (e.g., in a status bar). Returns nil if nothing is focused.
+func (app *Application) FocusedKeyHints() []KeyHint {
+	if app.focusedComponent == nil {
+		return nil
+	}
+	return app.focusedComponent.KeyHints()
+}
+
+// FocusedPane returns the innermost pane whose subtree contains the focused component
+// (i.e., the pane directly holding it), or nil if nothing is focused.
+func (app *Application) FocusedPane() *Pane {
+	if app.layout == nil {
+		return nil
+	}
+	path := app.layout.findComponentPath(app.focusedComponent)
+	if len(path) == 0 {
+		return nil
+	}
+	innermost := path[len(path)-1]
+	return innermost.layout.GetPaneBySlotIndex(innermost.slot)
+}
+
+// FocusedPaneNavIndex returns the navigation index (1-10) of the top-level pane containing
+// the focused component, as shown in pane borders and used by Alt+Number.
+// Returns 0 if nothing is focused or the containing pane has no navigation index.
+func (app *Application) FocusedPaneNavIndex() int {
+	if app.layout == nil {
+		return 0
+	}
+	path := app.layout.findComponentPath(app.focusedComponent)
+	if len(path) == 0 {
+		return 0
+	}
+	// Navigation indices are only assigned to the root layout's panes
+	pane := path[0].layout.GetPaneBySlotIndex(path[0].slot)
+	if pane == nil {
+		return 0
+	}
+	return pane.GetNavIndex()
+}
+
 // cycleFocus moves focus to the next or previous focusable component in the layout tree.
 func (app *Application) cycleFocus(forward bool) {
 	if app.layout == nil {
 		return
 	}
 
-	// Get all currently focusable components in the layout
+	// Get all currently focusable components in the layout (only the trapping pane's, if any)
 	focusables := app.layout.GetAllFocusableComponents()
+	if trap := app.focusTrapPane(); trap != nil {
+		focusables = trap.GetFocusableComponents()
+	}
 	count := len(focusables)
 	if count <= 1 {
 		// If only one focusable item, ensure it's focused
@@ -538,6 +1144,8 @@ func (app *Application) cycleFocus(forward bool) {
 		} else {
 			nextIndex = (currentIndex - 1 + count) % count // Modulo arithmetic for wrapping backward
 		}
+	} else if currentFocused != nil { // Focused component was removed or hidden since focus was set
+		nextIndex = app.nearestFocusIndex(focusables, currentFocused, forward)
 	} else if !forward { // Nothing focused, cycling backward
 		nextIndex = count - 1 // Start from the last item
 	}
@@ -547,6 +1155,113 @@ func (app *Application) cycleFocus(forward bool) {
 	app.SetFocus(focusables[nextIndex])
 }
 
+// focusTrapPane returns the innermost pane with SetFocusTrap enabled that contains the focused
+// component, or nil if focus isn't trapped.
+func (app *Application) focusTrapPane() *Pane {
+	if app.layout == nil || app.focusedComponent == nil {
+		return nil
+	}
+	var trap *Pane
+	for _, loc := range app.layout.findComponentPath(app.focusedComponent) {
+		if pane := loc.layout.panes[loc.slot].Pane; pane != nil && pane.focusTrap {
+			trap = pane
+		}
+	}
+	return trap
+}
+
+// exitFocusTrap moves focus out of the trapping pane to the next focusable component after it
+// (wrapping around). Returns false if focus isn't trapped or nothing outside the pane can take focus.
+func (app *Application) exitFocusTrap() bool {
+	trap := app.focusTrapPane()
+	if trap == nil {
+		return false
+	}
+	focusables := app.layout.GetAllFocusableComponents()
+	last := -1 // Index of the pane's last focusable component
+	for i, comp := range focusables {
+		if trap.ContainsFocus(comp) {
+			last = i
+		}
+	}
+	for i := 1; i <= len(focusables); i++ {
+		if next := focusables[(last+i)%len(focusables)]; !trap.ContainsFocus(next) {
+			app.SetFocus(next)
+			return true
+		}
+	}
+	return false
+}
+
+// nearestFocusIndex resolves the cycle target when the focused component is no longer in the
+// focusable list. It looks up the component's position in the focus order recorded when it was
+// focused and returns the index (in focusables) of the nearest still-present component in the
+// cycle direction: the one after it going forward, the one before it going backward.
+// Falls back to the first (forward) or last (backward) component if the old order doesn't help.
+func (app *Application) nearestFocusIndex(focusables []Component, removed Component, forward bool) int {
+	fallback := 0
+	if !forward {
+		fallback = len(focusables) - 1
+	}
+
+	oldIndex := -1
+	for i, comp := range app.focusOrder {
+		if comp == removed {
+			oldIndex = i
+			break
+		}
+	}
+	if oldIndex == -1 {
+		return fallback
+	}
+
+	present := make(map[Component]int, len(focusables))
+	for i, comp := range focusables {
+		present[comp] = i
+	}
+
+	// Walk the old order away from the removed component, wrapping around
+	oldCount := len(app.focusOrder)
+	for step := 1; step < oldCount; step++ {
+		j := (oldIndex + step) % oldCount
+		if !forward {
+			j = (oldIndex - step + oldCount) % oldCount
+		}
+		if idx, ok := present[app.focusOrder[j]]; ok {
+			return idx
+		}
+	}
+	return fallback
+}
+
+// Resize forces the layout to the given size and redraws, regardless of the size reported by the
+// terminal. Useful for PTY hosts that don't deliver resize events reliably, and for tests.
+// The size stays in effect until RefreshSize is called. Non-positive dimensions are ignored.
+func (app *Application) Resize(width, height int) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	app.forcedWidth, app.forcedHeight = width, height
+	app.QueueRedraw() // draw() re-lays out at the new size
+}
+
+// RefreshSize drops any size set by Resize, re-queries the terminal size and re-lays out.
+func (app *Application) RefreshSize() {
+	app.forcedWidth, app.forcedHeight = 0, 0
+	if app.screen != nil {
+		app.screen.Sync()
+	}
+	app.QueueRedraw()
+}
+
+// layoutSize returns the size the root layout is drawn at: the size forced by Resize, or the screen size.
+func (app *Application) layoutSize() (width, height int) {
+	if app.forcedWidth > 0 && app.forcedHeight > 0 {
+		return app.forcedWidth, app.forcedHeight
+	}
+	return app.screen.Size()
+}
+
 // handleResize handles terminal resize events.
 func (app *Application) handleResize(ev *tcell.EventResize) {
 	// Sync the screen size with tcell's internal state
@@ -607,20 +1322,46 @@ func (app *Application) ProcessEvent(ev tcell.Event) {
 		mod := ev.Modifiers()
 		r := ev.Rune()
 
+		// --- 0. Bracketed Paste --- (pasted keys only go to the focused component)
+		if app.pasting {
+			if focusedComp != nil && !app.inputBlocked(focusedComp) {
+				focusedComp.HandleEvent(ev)
+			}
+			return
+		}
+
 		// --- 1. Critical Global Keys ---
-		if key == tcell.KeyCtrlC {
+		if key == tcell.KeyCtrlC && (app.prompt != nil || !componentHandlesCopy(focusedComp) || app.inputBlocked(focusedComp)) {
 			app.Stop()
 			return
 		}
 
-		// --- 2. Focused Component Handling ---
-		if focusedComp != nil && focusedComp.HandleEvent(ev) {
+		// --- 1a. Pending Key Prompt (takes the key before any other handling) ---
+		if app.handlePromptKey(ev) {
 			return
 		}
 
-		// --- 3. Global Escape Key ---
+		// --- 1b. Keyboard Pane Resizing (Ctrl+Shift+Arrow, or arrows in resize mode) ---
+		if app.handleResizeKey(ev) {
+			return
+		}
+
+		// --- 1c. Inspector Overlay Toggle ---
+		if app.handleInspectorKey(ev) {
+			return
+		}
+
+		// --- 2. Focused Component Handling --- (skipped inside panes with input disabled)
+		if focusedComp != nil && !app.inputBlocked(focusedComp) && focusedComp.HandleEvent(ev) {
+			return
+		}
+
+		// --- 3. Global Escape Key --- (Escape or Enter first leaves a focus trap)
+		if (key == tcell.KeyEscape || key == tcell.KeyEnter) && app.exitFocusTrap() {
+			return
+		}
 		if key == tcell.KeyEscape {
-			app.Stop()
+			app.handleEscape()
 			return
 		}
 
@@ -673,6 +1414,16 @@ func (app *Application) ProcessEvent(ev tcell.Event) {
 			return
 		}
 
+		// --- 7. Relative Pane Navigation (Ctrl+PgDn / Ctrl+PgUp) ---
+		if mod == tcell.ModCtrl && key == tcell.KeyPgDn {
+			app.FocusNextPane()
+			return
+		}
+		if mod == tcell.ModCtrl && key == tcell.KeyPgUp {
+			app.FocusPrevPane()
+			return
+		}
+
 		// --- Event Ignored ---
 
 	case *tcell.EventResize:
@@ -681,8 +1432,16 @@ func (app *Application) ProcessEvent(ev tcell.Event) {
 		return
 
 	case *tcell.EventMouse:
-		// TODO: Implement Mouse Event Handling if needed
-		return // Ignore mouse for now
+		app.handleMouse(ev)
+		return
+
+	case *tcell.EventPaste:
+		// Bracketed paste start/end: the keys in between are routed to the focused component
+		app.pasting = ev.Start()
+		if focusedComp != nil && !app.inputBlocked(focusedComp) {
+			focusedComp.HandleEvent(ev)
+		}
+		return
 
 		// Handle other event types if necessary
 	}
@@ -708,8 +1467,8 @@ func (app *Application) handleAltNumberNavigation(targetNavIndex int) { // Now t
 		return // No pane found for this navigation index
 	}
 
-	// Find the first focusable component within that pane
-	comp := pane.GetFirstFocusableComponent()
+	// Find the pane's initial focus target (its first focusable component unless designated)
+	comp := pane.GetInitialFocusComponent()
 	if comp != nil {
 		app.SetFocus(comp) // Focus the found component
 	} else {
@@ -717,4 +1476,169 @@ func (app *Application) handleAltNumberNavigation(targetNavIndex int) { // Now t
 		// panes that have focusable children, but added as safety.
 		// appLog("Pane %d found but has no focusable component?", targetNavIndex)
 	}
+}
+
+// FocusNextPane moves focus to the initial focus component of the next navigable pane
+// in navigation index order, wrapping around after the last one.
+func (app *Application) FocusNextPane() {
+	app.cyclePaneFocus(true)
+}
+
+// FocusPrevPane moves focus to the initial focus component of the previous navigable pane
+// in navigation index order, wrapping around before the first one.
+func (app *Application) FocusPrevPane() {
+	app.cyclePaneFocus(false)
+}
+
+// cyclePaneFocus implements FocusNextPane/FocusPrevPane using the navigation indices (1-10)
+// assigned to the root layout's panes.
+func (app *Application) cyclePaneFocus(forward bool) {
+	if app.layout == nil {
+		return
+	}
+
+	// Collect the assigned navigation indices in ascending order
+	navIndices := make([]int, 0, 10)
+	for navIndex := 1; navIndex <= 10; navIndex++ {
+		if app.layout.GetPaneByNavIndex(navIndex) != nil {
+			navIndices = append(navIndices, navIndex)
+		}
+	}
+	if len(navIndices) == 0 {
+		return // No navigable panes
+	}
+
+	// Locate the current pane in the sequence (-1 if focus is outside any indexed pane)
+	current := app.FocusedPaneNavIndex()
+	pos := -1
+	for i, navIndex := range navIndices {
+		if navIndex == current {
+			pos = i
+			break
+		}
+	}
+
+	var next int
+	switch {
+	case pos == -1 && forward:
+		next = 0 // Start from the first pane
+	case pos == -1:
+		next = len(navIndices) - 1 // Start from the last pane
+	case forward:
+		next = (pos + 1) % len(navIndices)
+	default:
+		next = (pos - 1 + len(navIndices)) % len(navIndices)
+	}
+
+	app.handleAltNumberNavigation(navIndices[next]) // Same focus logic as Alt+Number
+}
+
+// SetResizeMode enables or disables keyboard pane-resize mode. While active, plain arrow keys
+// resize the pane containing the focused component and Escape/Enter leave the mode.
+// The pane being resized is highlighted in its border.
+func (app *Application) SetResizeMode(enabled bool) {
+	if app.resizeMode != enabled {
+		app.resizeMode = enabled
+		app.QueueRedraw() // Redraw to show/hide the resize cue
+	}
+}
+
+// IsResizeMode returns whether keyboard pane-resize mode is active.
+func (app *Application) IsResizeMode() bool {
+	return app.resizeMode
+}
+
+// SetResizeModeKey sets the key combination that toggles resize mode. The toggle is unbound by
+// default so that no key is taken from components and key handlers; the toggle is checked before
+// either of them, so pick a combination the application does not otherwise use.
+func (app *Application) SetResizeModeKey(combo KeyModCombo) {
+	app.resizeModeKey = &combo
+}
+
+// ResizeFocusedPane grows (delta > 0) or shrinks (delta < 0) the innermost pane containing the
+// focused component whose parent layout has siblings to redistribute space with.
+// Returns true if a pane was resized.
+func (app *Application) ResizeFocusedPane(delta int) bool {
+	target, ok := app.resizeTarget(Horizontal, false)
+	if !ok {
+		return false
+	}
+	return target.layout.ResizePane(target.slot, delta)
+}
+
+// resizeFocusedPaneAlong resizes the innermost pane containing the focused component
+// whose parent layout has the given orientation. Used by the arrow key bindings.
+func (app *Application) resizeFocusedPaneAlong(orientation Orientation, delta int) bool {
+	target, ok := app.resizeTarget(orientation, true)
+	if !ok {
+		return false
+	}
+	return target.layout.ResizePane(target.slot, delta)
+}
+
+// resizeTarget finds the innermost pane around the focused component whose parent layout
+// holds more than one pane. If oriented is true, only layouts with the given orientation qualify.
+func (app *Application) resizeTarget(orientation Orientation, oriented bool) (paneLocation, bool) {
+	if app.layout == nil || app.focusedComponent == nil {
+		return paneLocation{}, false
+	}
+	path := app.layout.findComponentPath(app.focusedComponent)
+	for i := len(path) - 1; i >= 0; i-- { // Innermost first
+		loc := path[i]
+		if loc.layout.activeCount < 2 {
+			continue // Nothing to redistribute with
+		}
+		if oriented && loc.layout.orientation != orientation {
+			continue
+		}
+		return loc, true
+	}
+	return paneLocation{}, false
+}
+
+// isResizeTarget reports whether the pane is the one currently targeted by resize mode.
+// Used by Pane during drawing to show the resize cue.
+func (app *Application) isResizeTarget(pane *Pane) bool {
+	if !app.resizeMode || pane == nil {
+		return false
+	}
+	target, ok := app.resizeTarget(Horizontal, false)
+	return ok && target.layout.GetPaneBySlotIndex(target.slot) == pane
+}
+
+// handleResizeKey processes the resize mode toggle and the arrow keys used for resizing.
+// Ctrl+Shift+Arrow resizes when there is a pane to resize and is otherwise left to the focused
+// component; plain arrows resize only while resize mode is active, which consumes them either way.
+// Returns true if the event was consumed.
+func (app *Application) handleResizeKey(ev *tcell.EventKey) bool {
+	if app.resizeModeKey != nil && app.resizeModeKey.Matches(ev) {
+		app.SetResizeMode(!app.resizeMode)
+		return true
+	}
+
+	direct := ev.Modifiers() == tcell.ModCtrl|tcell.ModShift
+	if !direct && !app.resizeMode {
+		return false
+	}
+
+	var resized bool
+	switch ev.Key() {
+	case tcell.KeyLeft:
+		resized = app.resizeFocusedPaneAlong(Horizontal, -1)
+	case tcell.KeyRight:
+		resized = app.resizeFocusedPaneAlong(Horizontal, 1)
+	case tcell.KeyUp:
+		resized = app.resizeFocusedPaneAlong(Vertical, -1)
+	case tcell.KeyDown:
+		resized = app.resizeFocusedPaneAlong(Vertical, 1)
+	case tcell.KeyEscape, tcell.KeyEnter:
+		if !app.resizeMode {
+			return false
+		}
+		app.SetResizeMode(false) // Leave resize mode
+		return true
+	default:
+		return false // Other keys keep their normal behavior
+	}
+	return resized || app.resizeMode
 }
\ No newline at end of file
@@ -0,0 +1,257 @@
+// application_test.go
+package tinytui
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// newFocusTestApp builds an application on a simulation screen with a row of panes,
+// each holding a text input, and focuses the input at index focused.
+func newFocusTestApp(t *testing.T, count, focused int) (*Application, *Layout, []*TextInput) {
+	t.Helper()
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("simulation screen: %v", err)
+	}
+	t.Cleanup(screen.Fini)
+	screen.SetSize(80, 10)
+
+	app := NewApplication()
+	app.screen = screen
+	layout := NewLayout(Horizontal)
+	inputs := make([]*TextInput, count)
+	for i := range inputs {
+		inputs[i] = NewTextInput()
+		pane := NewPane()
+		pane.SetChild(inputs[i])
+		layout.AddPane(pane, Size{Proportion: 1})
+	}
+	app.SetLayout(layout)
+	layout.SetRect(0, 0, 80, 10)
+	app.SetFocus(inputs[focused])
+	if app.GetFocusedComponent() != inputs[focused] {
+		t.Fatalf("setup: input %d did not take focus", focused)
+	}
+	return app, layout, inputs
+}
+
+// pressKey sends a key event through the application's event processing.
+func pressKey(app *Application, key tcell.Key) {
+	app.ProcessEvent(tcell.NewEventKey(key, 0, tcell.ModNone))
+}
+
+func TestCycleFocusAfterHidingFocused(t *testing.T) {
+	tests := []struct {
+		name string
+		key  tcell.Key
+		want int
+	}{
+		{"Tab moves to the next input", tcell.KeyTab, 2},
+		{"Shift+Tab moves to the previous input", tcell.KeyBacktab, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _, inputs := newFocusTestApp(t, 4, 1)
+			inputs[1].SetVisible(false)
+			pressKey(app, tt.key)
+			if got := app.GetFocusedComponent(); got != inputs[tt.want] {
+				t.Errorf("focused %v, want input %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleFocusAfterRemovingFocusedPane(t *testing.T) {
+	tests := []struct {
+		name    string
+		focused int
+		key     tcell.Key
+		want    int
+	}{
+		{"Tab moves to the next input", 2, tcell.KeyTab, 3},
+		{"Shift+Tab moves to the previous input", 2, tcell.KeyBacktab, 1},
+		{"Tab from the removed last input wraps to the first", 3, tcell.KeyTab, 0},
+		{"Shift+Tab from the removed first input wraps to the last", 0, tcell.KeyBacktab, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, layout, inputs := newFocusTestApp(t, 4, tt.focused)
+			layout.RemovePane(tt.focused)
+			pressKey(app, tt.key)
+			if got := app.GetFocusedComponent(); got != inputs[tt.want] {
+				t.Errorf("focused %v, want input %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleFocusWithoutFocus(t *testing.T) {
+	app, _, inputs := newFocusTestApp(t, 3, 0)
+	app.SetFocus(nil)
+	pressKey(app, tcell.KeyBacktab)
+	if got := app.GetFocusedComponent(); got != inputs[2] {
+		t.Errorf("Shift+Tab with nothing focused: focused %v, want the last input", got)
+	}
+	app.SetFocus(nil)
+	pressKey(app, tcell.KeyTab)
+	if got := app.GetFocusedComponent(); got != inputs[0] {
+		t.Errorf("Tab with nothing focused: focused %v, want the first input", got)
+	}
+}
+
+// dirtyProbe counts how often the main loop polls it for dirtiness, one poll per frame tick.
+type dirtyProbe struct {
+	BaseComponent
+	polls atomic.Int64
+}
+
+func (p *dirtyProbe) IsDirty() bool {
+	p.polls.Add(1)
+	return p.BaseComponent.IsDirty()
+}
+
+// startIdleApp runs an application holding a dirtyProbe on a simulation screen at 60 FPS in the
+// given render mode, and returns the probe once the initial frame has settled. The application
+// is stopped when the test or benchmark ends.
+func startIdleApp(tb testing.TB, mode RenderMode) *dirtyProbe {
+	tb.Helper()
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		tb.Fatalf("simulation screen: %v", err)
+	}
+	screen.SetSize(40, 5)
+
+	probe := &dirtyProbe{BaseComponent: NewBaseComponent()}
+	pane := NewPane()
+	pane.SetChild(probe)
+	layout := NewLayout(Horizontal)
+	layout.AddPane(pane, Size{Proportion: 1})
+
+	app := NewApplication()
+	app.screen = screen
+	app.SetLayout(layout)
+	app.SetMaxFPS(60)
+	app.SetRenderMode(mode)
+
+	done := make(chan error, 1)
+	go func() { done <- app.Run() }()
+	tb.Cleanup(func() {
+		app.Stop()
+		screen.PostEvent(tcell.NewEventInterrupt(nil)) // Wake the blocked event poller
+		if err := <-done; err != nil {
+			tb.Errorf("Run: %v", err)
+		}
+	})
+	time.Sleep(50 * time.Millisecond) // Let the initial frame settle
+	return probe
+}
+
+// benchmarkIdle measures an idle application: each op is 100ms without input. It reports the main
+// loop's wake-ups and, where the platform exposes it, the process CPU time per idle second.
+func benchmarkIdle(b *testing.B, mode RenderMode) {
+	probe := startIdleApp(b, mode)
+	probe.polls.Store(0)
+	cpuStart, haveCPU := processCPUTime()
+	start := time.Now()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	b.StopTimer()
+	seconds := time.Since(start).Seconds()
+	b.ReportMetric(float64(probe.polls.Load())/seconds, "wakeups/s")
+	if cpuEnd, ok := processCPUTime(); ok && haveCPU {
+		b.ReportMetric(float64((cpuEnd-cpuStart).Microseconds())/seconds, "cpu-us/s")
+	}
+}
+
+func BenchmarkIdleFixedTick(b *testing.B) { benchmarkIdle(b, RenderFixedTick) }
+
+func BenchmarkIdleOnDemand(b *testing.B) { benchmarkIdle(b, RenderOnDemand) }
+
+func TestRenderModeIdleWakeups(t *testing.T) {
+	const idle = 250 * time.Millisecond
+	tests := []struct {
+		name     string
+		mode     RenderMode
+		minPolls int64
+		maxPolls int64
+	}{
+		{"fixed tick polls while idle", RenderFixedTick, 5, 1 << 30},
+		{"on demand does not wake while idle", RenderOnDemand, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe := startIdleApp(t, tt.mode)
+			probe.polls.Store(0)
+			time.Sleep(idle)
+			polls := probe.polls.Load()
+
+			if polls < tt.minPolls || polls > tt.maxPolls {
+				t.Errorf("%d dirty polls in %v idle, want %d..%d", polls, idle, tt.minPolls, tt.maxPolls)
+			}
+		})
+	}
+}
+
+func TestResizeModeKeyUnboundByDefault(t *testing.T) {
+	app, _, _ := newFocusTestApp(t, 2, 0)
+	handled := false
+	app.RegisterKeyHandler(tcell.KeyCtrlR, tcell.ModCtrl, func() bool {
+		handled = true
+		return true
+	})
+
+	ctrlR := tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModCtrl)
+	app.ProcessEvent(ctrlR)
+	if !handled {
+		t.Error("Ctrl+R did not reach the registered key handler")
+	}
+	if app.IsResizeMode() {
+		t.Error("Ctrl+R toggled resize mode without SetResizeModeKey")
+	}
+
+	handled = false
+	app.SetResizeModeKey(KeyModCombo{Key: tcell.KeyF6})
+	pressKey(app, tcell.KeyF6)
+	if !app.IsResizeMode() {
+		t.Error("bound key did not toggle resize mode")
+	}
+	app.ProcessEvent(ctrlR)
+	if !handled {
+		t.Error("Ctrl+R stopped reaching the key handler after binding F6")
+	}
+}
+
+func TestCtrlShiftArrowWithoutResizeTarget(t *testing.T) {
+	tests := []struct {
+		name       string
+		panes      int
+		wantResize bool
+	}{
+		{"single pane passes the key on", 1, false},
+		{"two panes resize", 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, layout, inputs := newFocusTestApp(t, tt.panes, 0)
+			inputs[0].SetText("one two")
+			cursor := inputs[0].cursorPos
+			_, _, width, _ := inputs[0].GetRect()
+
+			app.ProcessEvent(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModCtrl|tcell.ModShift))
+			layout.SetRect(0, 0, 80, 10)
+			_, _, newWidth, _ := inputs[0].GetRect()
+			if resized := newWidth != width; resized != tt.wantResize {
+				t.Errorf("pane resized: %v, want %v", resized, tt.wantResize)
+			}
+			if moved := inputs[0].cursorPos != cursor; moved == tt.wantResize {
+				t.Errorf("input cursor moved: %v, want %v", moved, !tt.wantResize)
+			}
+		})
+	}
+}
\ No newline at end of file
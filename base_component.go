@@ -14,6 +14,30 @@ type BaseComponent struct {
 	dirty   bool         // Does the component need to be redrawn?
 	state   State        // Current interaction state (Normal, Selected, Interacted)
 	app     *Application // Reference to the parent application
+
+	onResize func(oldWidth, oldHeight, newWidth, newHeight int) // Called when SetRect changes the size
+
+	cache *renderCache // Cell buffer of the last drawn frame (nil unless SetCached is on)
+
+	actions *ActionMap // Key-to-action bindings (nil = DefaultActionMap)
+}
+
+// renderCache holds the cells a cached component drew in its rectangle, replayed while it is clean.
+type renderCache struct {
+	rect  Rect         // Rectangle the cells were captured from
+	cells []cachedCell // Captured cells in row-major order (empty = nothing captured yet)
+}
+
+// cachedCell is one captured screen cell.
+type cachedCell struct {
+	mainc rune
+	combc []rune
+	style tcell.Style
+}
+
+// cacheable is implemented by components embedding BaseComponent; it exposes the render cache.
+type cacheable interface {
+	renderCache() *renderCache
 }
 
 // NewBaseComponent creates a new BaseComponent with sensible defaults.
@@ -28,12 +52,127 @@ func NewBaseComponent() BaseComponent {
 }
 
 // SetRect sets the component's position and size.
-// Marks the component as dirty if the rectangle changes.
+// Marks the component as dirty if the rectangle changes, and calls the OnResize hook
+// if the width or height changed (moves alone don't count).
 func (b *BaseComponent) SetRect(x, y, width, height int) {
 	newRect := Rect{X: x, Y: y, Width: width, Height: height}
 	if b.rect != newRect {
+		oldRect := b.rect
 		b.rect = newRect
 		b.MarkDirty() // Geometry change requires redraw
+		if b.onResize != nil && (oldRect.Width != width || oldRect.Height != height) {
+			b.onResize(oldRect.Width, oldRect.Height, width, height)
+		}
+	}
+}
+
+// SetOnResize sets a hook called by SetRect after the component's size changes, e.g., to
+// invalidate caches that depend on the width. Layouts call SetRect on every recalculation,
+// so this fires on terminal resizes as well as layout changes.
+func (b *BaseComponent) SetOnResize(handler func(oldWidth, oldHeight, newWidth, newHeight int)) {
+	b.onResize = handler
+}
+
+// SetCached enables or disables render caching for static content. While enabled, the component
+// is drawn once into a cell buffer and later frames copy the buffer to the screen instead of
+// running Draw, until the component is marked dirty or its rectangle changes. This trades memory
+// for CPU on large, rarely changing panels (e.g., a long static Text). Don't enable it on
+// components that position the terminal cursor or draw animations without marking themselves dirty.
+func (b *BaseComponent) SetCached(cached bool) {
+	if cached == (b.cache != nil) {
+		return
+	}
+	if cached {
+		b.cache = &renderCache{}
+	} else {
+		b.cache = nil
+	}
+	b.MarkDirty()
+}
+
+// IsCached returns whether render caching is enabled.
+func (b *BaseComponent) IsCached() bool {
+	return b.cache != nil
+}
+
+// renderCache returns the component's render cache (nil if caching is off). Implements cacheable.
+func (b *BaseComponent) renderCache() *renderCache {
+	return b.cache
+}
+
+// SetActionMap sets the key-to-action bindings used by the component's key handling.
+// Pass nil to follow DefaultActionMap again.
+func (b *BaseComponent) SetActionMap(m *ActionMap) {
+	b.actions = m
+}
+
+// GetActionMap returns the component's ActionMap, or DefaultActionMap if it has none of its own.
+func (b *BaseComponent) GetActionMap() *ActionMap {
+	if b.actions == nil {
+		return DefaultActionMap()
+	}
+	return b.actions
+}
+
+// SetActionBinding remaps action to combo for this component only (see ActionMap.SetActionBinding).
+// The first call gives the component its own copy of DefaultActionMap, so later changes to the
+// global map no longer apply to it.
+func (b *BaseComponent) SetActionBinding(action string, combo KeyModCombo) {
+	if b.actions == nil {
+		b.actions = DefaultActionMap().Clone()
+	}
+	b.actions.SetActionBinding(action, combo)
+}
+
+// resolveAction returns the action bound to the key event in the component's ActionMap ("" if none).
+func (b *BaseComponent) resolveAction(ev *tcell.EventKey) string {
+	return b.GetActionMap().Resolve(ev)
+}
+
+// drawComponent draws a container's child, replaying its render cache when caching is enabled and
+// nothing changed since the cache was captured. Otherwise the component draws itself and, if cached,
+// its cells are read back from the screen into the cache.
+func drawComponent(screen tcell.Screen, comp Component) {
+	cc, ok := comp.(cacheable)
+	if !ok || cc.renderCache() == nil {
+		comp.Draw(screen)
+		return
+	}
+	cache := cc.renderCache()
+
+	x, y, width, height := comp.GetRect()
+	rect := Rect{X: x, Y: y, Width: width, Height: height}
+	screenWidth, screenHeight := screen.Size()
+	visible := Rect{X: max(x, 0), Y: max(y, 0)}
+	visible.Width = min(x+width, screenWidth) - visible.X
+	visible.Height = min(y+height, screenHeight) - visible.Y
+	if !comp.IsVisible() || visible.Width <= 0 || visible.Height <= 0 {
+		comp.Draw(screen)
+		return
+	}
+
+	// --- Replay --- (clean, same place, already captured)
+	if !comp.IsDirty() && cache.rect == rect && len(cache.cells) == visible.Width*visible.Height {
+		i := 0
+		for cy := visible.Y; cy < visible.Y+visible.Height; cy++ {
+			for cx := visible.X; cx < visible.X+visible.Width; cx++ {
+				cell := cache.cells[i]
+				screen.SetContent(cx, cy, cell.mainc, cell.combc, cell.style)
+				i++
+			}
+		}
+		return
+	}
+
+	// --- Draw and Capture ---
+	comp.Draw(screen)
+	cache.rect = rect
+	cache.cells = cache.cells[:0]
+	for cy := visible.Y; cy < visible.Y+visible.Height; cy++ {
+		for cx := visible.X; cx < visible.X+visible.Width; cx++ {
+			mainc, combc, style, _ := screen.GetContent(cx, cy)
+			cache.cells = append(cache.cells, cachedCell{mainc: mainc, combc: combc, style: style})
+		}
 	}
 }
 
@@ -154,4 +293,17 @@ func (b *BaseComponent) HandleEvent(event tcell.Event) bool {
 // Concrete components override this to draw their content onto the screen.
 func (b *BaseComponent) Draw(screen tcell.Screen) {
 	// Base component doesn't draw anything itself.
+}
+
+// KeyHints provides a default implementation returning no hints.
+// Interactive components override this to describe the keys they handle.
+func (b *BaseComponent) KeyHints() []KeyHint {
+	return nil
+}
+
+// ApplyTheme provides a default ThemedComponent implementation.
+// Base implementation just marks the component dirty so it is redrawn with the new theme.
+// Concrete components override this to refresh styles derived from the theme.
+func (b *BaseComponent) ApplyTheme(theme Theme) {
+	b.MarkDirty()
 }
\ No newline at end of file
@@ -0,0 +1,69 @@
+// base_component_test.go
+package tinytui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// newStaticText builds a large wrapped Text filling a width x height simulation screen.
+func newStaticText(tb testing.TB, width, height int) (tcell.Screen, *Text) {
+	tb.Helper()
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		tb.Fatalf("simulation screen: %v", err)
+	}
+	tb.Cleanup(screen.Fini)
+	screen.SetSize(width, height)
+
+	line := strings.Repeat("lorem ipsum dolor sit amet, consectetur adipiscing elit ", 8)
+	text := NewText(strings.Repeat(line+"\n", height))
+	text.SetWrap(true)
+	text.SetRect(0, 0, width, height)
+	return screen, text
+}
+
+func TestDrawComponentReplaysCache(t *testing.T) {
+	screen, text := newStaticText(t, 40, 6)
+	text.SetCached(true)
+	drawComponent(screen, text)
+	text.ClearDirty()
+	want := make([]string, 6)
+	for y := range want {
+		want[y] = screenText(screen, 0, y, 40)
+	}
+
+	screen.Clear()
+	drawComponent(screen, text) // Clean: replayed from the cache
+	for y := range want {
+		if got := screenText(screen, 0, y, 40); got != want[y] {
+			t.Errorf("row %d replayed as %q, want %q", y, got, want[y])
+		}
+	}
+}
+
+// BenchmarkDrawComponentStaticText measures a frame of a clean 200x60 wrapped Text drawn
+// through drawComponent with and without SetCached.
+func BenchmarkDrawComponentStaticText(b *testing.B) {
+	for _, cached := range []bool{false, true} {
+		name := "uncached"
+		if cached {
+			name = "cached"
+		}
+		b.Run(name, func(b *testing.B) {
+			screen, text := newStaticText(b, 200, 60)
+			text.SetCached(cached)
+			drawComponent(screen, text) // First frame draws and captures
+			text.ClearDirty()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				drawComponent(screen, text)
+				text.ClearDirty()
+			}
+		})
+	}
+}
\ No newline at end of file
@@ -7,6 +7,7 @@ type BaseTheme struct {
 	name              ThemeName // Unique identifier (e.g., "default", "turbo")
 	textStyle         Style     // Default text style
 	textSelectedStyle Style     // Style for selected text (e.g., in a future List component)
+	highlightStyle    Style     // Style for search matches and highlighted spans
 
 	// Grid styles for various states
 	gridStyle                  Style // Normal, unfocused cell
@@ -15,6 +16,7 @@ type BaseTheme struct {
 	gridFocusedStyle           Style // Normal cell when grid itself has focus
 	gridFocusedSelectedStyle   Style // Selected cell when grid has focus
 	gridFocusedInteractedStyle Style // Interacted cell when grid has focus
+	gridHeaderStyle            Style // Column title row
 
 	// Pane styles
 	paneStyle            Style  // Background style for the pane's content area
@@ -22,6 +24,14 @@ type BaseTheme struct {
 	paneFocusBorderStyle Style  // Style for the pane's border when focused (or child focused)
 	defaultBorderType    Border // Default border type (e.g., Single, Double) for unfocused panes
 	focusedBorderType    Border // Border type to use when the pane (or a child) is focused
+	focusRing            bool   // Draw a highlighted outline around the focused component?
+
+	// Chrome styles
+	scrollBarStyle      Style // Scrollbar track
+	scrollBarThumbStyle Style // Scrollbar thumb
+	focusRingStyle      Style // Outline around the focused component
+	shadowStyle         Style // Pane drop shadows (DefaultStyle = darken the cells underneath)
+	separatorStyle      Style // Separator lines between components
 
 	// Other theme attributes
 	indicatorColor    Color // Color for indicators (e.g., selection cursor in Grid)
@@ -45,6 +55,11 @@ func (t *BaseTheme) TextSelectedStyle() Style {
 	return t.textSelectedStyle
 }
 
+// HighlightStyle returns the style for search matches and highlighted spans.
+func (t *BaseTheme) HighlightStyle() Style {
+	return t.highlightStyle
+}
+
 // GridStyle returns the style for normal, unfocused grid cells.
 func (t *BaseTheme) GridStyle() Style {
 	return t.gridStyle
@@ -75,6 +90,11 @@ func (t *BaseTheme) GridFocusedInteractedStyle() Style {
 	return t.gridFocusedInteractedStyle
 }
 
+// GridHeaderStyle returns the style for the grid's column title row.
+func (t *BaseTheme) GridHeaderStyle() Style {
+	return t.gridHeaderStyle
+}
+
 // PaneStyle returns the style for pane content areas (background).
 func (t *BaseTheme) PaneStyle() Style {
 	return t.paneStyle
@@ -90,6 +110,31 @@ func (t *BaseTheme) PaneFocusBorderStyle() Style {
 	return t.paneFocusBorderStyle
 }
 
+// ScrollBarStyle returns the style for scrollbar tracks.
+func (t *BaseTheme) ScrollBarStyle() Style {
+	return t.scrollBarStyle
+}
+
+// ScrollBarThumbStyle returns the style for scrollbar thumbs.
+func (t *BaseTheme) ScrollBarThumbStyle() Style {
+	return t.scrollBarThumbStyle
+}
+
+// FocusRingStyle returns the style for the outline around the focused component.
+func (t *BaseTheme) FocusRingStyle() Style {
+	return t.focusRingStyle
+}
+
+// ShadowStyle returns the style for pane drop shadows (DefaultStyle = darken the cells underneath).
+func (t *BaseTheme) ShadowStyle() Style {
+	return t.shadowStyle
+}
+
+// SeparatorStyle returns the style for separator lines between components.
+func (t *BaseTheme) SeparatorStyle() Style {
+	return t.separatorStyle
+}
+
 // DefaultCellWidth returns the theme's preferred default width for grid cells.
 func (t *BaseTheme) DefaultCellWidth() int {
 	return t.defaultCellWidth
@@ -120,6 +165,11 @@ func (t *BaseTheme) FocusedBorderType() Border {
 	return t.focusedBorderType
 }
 
+// FocusRingEnabled reports whether the theme draws a focus ring around the focused component.
+func (t *BaseTheme) FocusRingEnabled() bool {
+	return t.focusRing
+}
+
 // --- Concrete Theme Definitions ---
 
 // NewDefaultTheme creates the default light-background theme.
@@ -132,22 +182,31 @@ func NewDefaultTheme() Theme {
 	focusedStyle := baseStyle                                                                    // No change for base cell when grid focused
 	focusedSelectedStyle := baseStyle.Background(ColorYellow).Foreground(ColorBlack).Bold(true)  // High contrast selection when focused
 	focusedInteractedStyle := baseStyle.Background(ColorGreen).Foreground(ColorBlack).Bold(true) // High contrast interaction when focused
+	headerStyle := baseStyle.Bold(true).Underline(true)                                          // Underlined column titles
 
 	return &BaseTheme{
 		name:                       ThemeDefault,
 		textStyle:                  baseStyle,
-		textSelectedStyle:          selectedStyle.Reverse(true), // Use reverse video for selected text areas
+		textSelectedStyle:          selectedStyle.Reverse(true),                            // Use reverse video for selected text areas
+		highlightStyle:             baseStyle.Background(ColorTeal).Foreground(ColorBlack), // Teal marks search matches
 		gridStyle:                  baseStyle,
 		gridSelectedStyle:          selectedStyle,
 		gridInteractedStyle:        interactedStyle,
 		gridFocusedStyle:           focusedStyle, // Focused grid uses base style for normal cells
 		gridFocusedSelectedStyle:   focusedSelectedStyle,
 		gridFocusedInteractedStyle: focusedInteractedStyle,
+		gridHeaderStyle:            headerStyle,
 		paneStyle:                  baseStyle,                                    // Pane background is default terminal bg
 		paneBorderStyle:            baseStyle,                                    // Pane border uses default terminal fg/bg
 		paneFocusBorderStyle:       baseStyle.Foreground(ColorYellow).Bold(true), // Focused border is yellow and bold
 		defaultBorderType:          BorderSingle,
 		focusedBorderType:          BorderSingle, // Focus doesn't change border type in default theme
+		focusRing:                  false,        // Focus is shown by the pane border and component styles only
+		scrollBarStyle:             baseStyle,
+		scrollBarThumbStyle:        baseStyle,
+		focusRingStyle:             baseStyle.Background(ColorTeal).Foreground(ColorBlack), // Same teal as highlights
+		shadowStyle:                DefaultStyle,                                           // Darken whatever is underneath
+		separatorStyle:             baseStyle,                                              // Separators match pane borders
 		defaultCellWidth:           10,
 		defaultCellHeight:          1,
 		indicatorColor:             ColorRed, // Selection indicator is red
@@ -184,22 +243,31 @@ func NewTurboTheme() Theme {
 	focusedStyle := baseStyle // Base style when grid is focused but cell is normal
 	focusedSelectedStyle := DefaultStyle.Background(highlightBg).Foreground(highlightFg).Bold(true)
 	focusedInteractedStyle := DefaultStyle.Background(interactedBg).Foreground(interactedFg).Bold(true)
+	headerStyle := DefaultStyle.Background(ColorTeal).Foreground(ColorWhite).Bold(true) // Teal title bar for column headers
 
 	return &BaseTheme{
 		name:                       ThemeTurbo,
 		textStyle:                  baseStyle,
-		textSelectedStyle:          selectedStyle.Reverse(true), // Use reverse of the unfocused selected style for text areas
+		textSelectedStyle:          selectedStyle.Reverse(true),                                // Use reverse of the unfocused selected style for text areas
+		highlightStyle:             DefaultStyle.Background(ColorOlive).Foreground(ColorWhite), // Dark yellow marks search matches
 		gridStyle:                  baseStyle,
 		gridSelectedStyle:          selectedStyle,
 		gridInteractedStyle:        interactedStyle,
 		gridFocusedStyle:           focusedStyle,
 		gridFocusedSelectedStyle:   focusedSelectedStyle,
 		gridFocusedInteractedStyle: focusedInteractedStyle,
+		gridHeaderStyle:            headerStyle,
 		paneStyle:                  baseStyle,                                         // Pane background uses theme base
 		paneBorderStyle:            baseStyle.Foreground(borderColor),                 // Use theme bg, specific border fg
 		paneFocusBorderStyle:       baseStyle.Foreground(borderFocusColor).Bold(true), // Use theme bg, specific focus border fg + bold
 		defaultBorderType:          BorderSingle,                                      // Default to single border
 		focusedBorderType:          BorderDouble,                                      // Use double border when focused
+		focusRing:                  false,                                             // Double border already marks focus
+		scrollBarStyle:             baseStyle.Foreground(borderColor),                 // Silver track
+		scrollBarThumbStyle:        baseStyle.Foreground(ColorWhite),                  // White thumb stands out from the track
+		focusRingStyle:             DefaultStyle.Background(ColorOlive).Foreground(ColorWhite),
+		shadowStyle:                DefaultStyle.Background(ColorBlack).Foreground(ColorGray), // Classic black shadow
+		separatorStyle:             baseStyle.Foreground(borderColor),                         // Separators match pane borders
 		defaultCellWidth:           10,
 		defaultCellHeight:          1,
 		indicatorColor:             ColorRed, // Keep indicator red for high visibility
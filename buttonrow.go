@@ -0,0 +1,253 @@
+// buttonrow.go
+package tinytui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// rowButton is a single labeled button within a ButtonRow.
+type rowButton struct {
+	label   string // Text shown inside the button brackets
+	onClick func() // Called when the button is activated (may be nil)
+}
+
+// ButtonRow displays a horizontal row of buttons (e.g., OK / Cancel in a dialog).
+// The row is a single focusable component; Left/Right and Tab/Shift+Tab move between buttons
+// and Enter/Space activates the selected one. Tab past the last button releases focus normally.
+type ButtonRow struct {
+	BaseComponent
+	buttons       []rowButton   // Buttons in display and focus order
+	selected      int           // Index of the currently selected button (-1 if none)
+	spacing       int           // Number of cells between adjacent buttons
+	alignment     AlignmentText // Horizontal placement of the row within the component
+	style         Style         // Style for unselected buttons and the background
+	selectedStyle Style         // Style for the selected button when the row has focus
+}
+
+// NewButtonRow creates an empty, right-aligned button row with styles from the current theme.
+func NewButtonRow() *ButtonRow {
+	theme := GetTheme()
+	if theme == nil {
+		theme = NewDefaultTheme()
+	} // Fallback
+
+	b := &ButtonRow{
+		BaseComponent: NewBaseComponent(),
+		buttons:       make([]rowButton, 0),
+		selected:      -1,
+		spacing:       2,              // Default gap between buttons
+		alignment:     AlignTextRight, // Dialog convention: buttons sit at the right
+	}
+	b.ApplyTheme(theme)
+	return b
+}
+
+// ApplyTheme updates the button styles based on the provided theme.
+// Implements ThemedComponent.
+func (b *ButtonRow) ApplyTheme(theme Theme) {
+	if theme == nil {
+		return
+	}
+	b.style = theme.TextStyle()
+	b.selectedStyle = theme.TextSelectedStyle()
+	b.MarkDirty()
+}
+
+// AddButton appends a button with the given label and click handler.
+// Returns the button's index. The first button added becomes selected.
+func (b *ButtonRow) AddButton(label string, onClick func()) int {
+	b.buttons = append(b.buttons, rowButton{label: label, onClick: onClick})
+	if b.selected < 0 {
+		b.selected = 0
+	}
+	b.MarkDirty()
+	return len(b.buttons) - 1
+}
+
+// SetSpacing sets the number of cells between adjacent buttons.
+func (b *ButtonRow) SetSpacing(spacing int) {
+	if spacing < 0 {
+		spacing = 0
+	}
+	if b.spacing != spacing {
+		b.spacing = spacing
+		b.MarkDirty()
+	}
+}
+
+// SetAlignment sets the horizontal placement of the buttons (left, center or right).
+func (b *ButtonRow) SetAlignment(align AlignmentText) {
+	if b.alignment != align {
+		b.alignment = align
+		b.MarkDirty()
+	}
+}
+
+// SetSelected selects the button at the given index. Out-of-range indices are ignored.
+func (b *ButtonRow) SetSelected(index int) {
+	if index < 0 || index >= len(b.buttons) || index == b.selected {
+		return
+	}
+	b.selected = index
+	b.MarkDirty()
+}
+
+// GetSelected returns the index of the selected button, or -1 if the row is empty.
+func (b *ButtonRow) GetSelected() int {
+	return b.selected
+}
+
+// PreferredWidth returns the number of cells needed to draw all buttons with spacing.
+func (b *ButtonRow) PreferredWidth() int {
+	total := 0
+	for i := range b.buttons {
+		if i > 0 {
+			total += b.spacing
+		}
+		total += buttonWidth(b.buttons[i].label)
+	}
+	return total
+}
+
+// PreferredSize returns the space needed to draw all buttons on one line.
+// Implements Measurable.
+func (b *ButtonRow) PreferredSize() (width, height int) {
+	return b.PreferredWidth(), 1
+}
+
+// MinSize returns the space needed to show the widest button on a single line.
+// Implements Constrained.
+func (b *ButtonRow) MinSize() (width, height int) {
+	for i := range b.buttons {
+		width = max(width, buttonWidth(b.buttons[i].label))
+	}
+	return width, 1
+}
+
+// buttonWidth returns the drawn width of a button label, including its "[ " and " ]" brackets.
+func buttonWidth(label string) int {
+	return runewidth.StringWidth(label) + 4
+}
+
+// Focusable returns true when the row is visible and has at least one button.
+func (b *ButtonRow) Focusable() bool {
+	return b.IsVisible() && len(b.buttons) > 0
+}
+
+// KeyHints returns the keys the button row responds to while focused.
+func (b *ButtonRow) KeyHints() []KeyHint {
+	return []KeyHint{
+		{Key: "←/→", Description: "choose"},
+		{Key: "Enter/Space", Description: "press"},
+	}
+}
+
+// Draw renders the buttons on the first line of the component's rectangle.
+func (b *ButtonRow) Draw(screen tcell.Screen) {
+	if !b.IsVisible() {
+		return
+	}
+
+	x, y, width, height := b.GetRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	Fill(screen, x, y, width, height, ' ', b.style)
+
+	// Position the row according to alignment (clamped to the left edge if it doesn't fit)
+	startX := x
+	rowWidth := b.PreferredWidth()
+	switch b.alignment {
+	case AlignTextCenter:
+		startX = x + (width-rowWidth)/2
+	case AlignTextRight:
+		startX = x + width - rowWidth
+	}
+	if startX < x {
+		startX = x
+	}
+
+	isFocused := b.IsFocused()
+	drawX := startX
+	for i, button := range b.buttons {
+		available := x + width - drawX
+		if available <= 0 {
+			break
+		}
+		style := b.style
+		if i == b.selected && isFocused {
+			style = b.selectedStyle
+		}
+		text := runewidth.Truncate("[ "+button.label+" ]", available, "…")
+		DrawText(screen, drawX, y, style, text)
+		drawX += buttonWidth(button.label) + b.spacing
+	}
+}
+
+// HandleEvent processes keys for moving between buttons and activating them.
+// Tab/Shift+Tab are only consumed while there is another button in that direction.
+func (b *ButtonRow) HandleEvent(event tcell.Event) bool {
+	keyEvent, ok := event.(*tcell.EventKey)
+	if !ok || len(b.buttons) == 0 {
+		return false
+	}
+
+	switch b.resolveAction(keyEvent) {
+	case ActionLeft:
+		b.moveSelection(-1)
+		return true
+	case ActionRight:
+		b.moveSelection(1)
+		return true
+	}
+
+	switch keyEvent.Key() {
+	case tcell.KeyTab:
+		return b.moveSelection(1) // Let the app move focus on from the last button
+	case tcell.KeyBacktab:
+		return b.moveSelection(-1) // Let the app move focus back from the first button
+	case tcell.KeyEnter:
+		b.activate()
+		return true
+	case tcell.KeyRune:
+		if keyEvent.Rune() == ' ' {
+			b.activate()
+			return true
+		}
+	}
+	return false
+}
+
+// moveSelection moves the selection by delta without wrapping.
+// Returns true if the selection changed.
+func (b *ButtonRow) moveSelection(delta int) bool {
+	next := b.selected + delta
+	if next < 0 || next >= len(b.buttons) {
+		return false
+	}
+	b.selected = next
+	b.MarkDirty()
+	return true
+}
+
+// Click selects the button at index and invokes its click handler, as if Enter were pressed on it.
+// Out-of-range indices are ignored. Call from the main loop (e.g., a key handler or a command).
+func (b *ButtonRow) Click(index int) {
+	if index < 0 || index >= len(b.buttons) {
+		return
+	}
+	b.SetSelected(index)
+	b.activate()
+}
+
+// activate invokes the click handler of the selected button.
+func (b *ButtonRow) activate() {
+	if b.selected < 0 || b.selected >= len(b.buttons) {
+		return
+	}
+	if handler := b.buttons[b.selected].onClick; handler != nil {
+		handler()
+	}
+}
\ No newline at end of file
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -52,53 +53,50 @@ func updateStatus(msg string) {
 	}
 }
 
-// updateSprite updates the sprite periodically
+// updateSprite animates the sprite from a background task. The task only counts frames; each frame
+// is reported as progress and drawn on the main loop, so the sprite is never touched concurrently.
 func updateSprite() {
-	go func() {
+	animate := func(ctx context.Context, progress func(frame float64)) error {
 		tick := time.NewTicker(500 * time.Millisecond)
 		defer tick.Stop()
-		state := 0
-		for range tick.C {
-			currentApp := appInstance
-			if currentApp == nil {
-				log.Println("updateSprite: App instance is nil, exiting goroutine.")
-				return
-			}
-
-			currentSprite := spriteComp
-			if currentSprite == nil {
-				continue
+		for frame := 0; ; frame++ {
+			select {
+			case <-ctx.Done():
+				return ctx.Err() // Application stopped
+			case <-tick.C:
+				progress(float64(frame))
 			}
+		}
+	}
+	appInstance.RunTask(animate, func(app *tinytui.Application, frame float64) {
+		drawSpriteFrame(int(frame))
+	}, nil)
+}
 
-			w, h := currentSprite.Dimensions()
-			if w == 0 || h == 0 {
-				continue
-			}
+// drawSpriteFrame fills the sprite with the checkerboard for the given animation state
+func drawSpriteFrame(state int) {
+	w, h := spriteComp.Dimensions()
+	if w == 0 || h == 0 {
+		return
+	}
 
-			cells := make([][]tinytui.SpriteCell, h) // Create new cells each time
-			for r := 0; r < h; r++ {
-				cells[r] = make([]tinytui.SpriteCell, w)
-				for c := 0; c < w; c++ {
-					style := tinytui.DefaultStyle
-					runeChar := ' '
-					if (r+c+state)%2 == 0 {
-						style = style.Background(tinytui.ColorRed)
-						runeChar = '*'
-					} else {
-						style = style.Background(tinytui.ColorBlue)
-						runeChar = '+'
-					}
-					cells[r][c] = tinytui.SpriteCell{Rune: runeChar, Style: style}
-				}
+	cells := make([][]tinytui.SpriteCell, h) // Create new cells each time
+	for r := 0; r < h; r++ {
+		cells[r] = make([]tinytui.SpriteCell, w)
+		for c := 0; c < w; c++ {
+			style := tinytui.DefaultStyle
+			runeChar := ' '
+			if (r+c+state)%2 == 0 {
+				style = style.Background(tinytui.ColorRed)
+				runeChar = '*'
+			} else {
+				style = style.Background(tinytui.ColorBlue)
+				runeChar = '+'
 			}
-			state++
-
-			currentApp.Dispatch(&tinytui.UpdateSpriteCommand{
-				Target:  currentSprite,
-				Content: cells,
-			})
+			cells[r][c] = tinytui.SpriteCell{Rune: runeChar, Style: style}
 		}
-	}()
+	}
+	spriteComp.SetCells(cells)
 }
 
 func main() {
@@ -123,18 +121,21 @@ func main() {
 	statusText = tinytui.NewText("Status: Initializing...")
 
 	nameInput := tinytui.NewTextInput()
-	nameInput.SetText("Enter Name")
+	nameInput.SetPlaceholder("Enter Name")
 	submitButton := tinytui.NewGrid()
 	submitButton.SetCells([][]string{{" Submit "}})
 	submitButton.SetCellSize(10, 1)
 	submitButton.SetSelectionMode(tinytui.SingleSelect)
+	submitButton.SetButtonMode(true)
 	themeButton := tinytui.NewGrid()
 	themeButton.SetCells([][]string{{" Theme "}})
 	themeButton.SetCellSize(9, 1)
 	themeButton.SetSelectionMode(tinytui.SingleSelect)
+	themeButton.SetButtonMode(true)
 
 	logText = tinytui.NewText("--- Event Log ---")
 	logText.SetWrap(true)
+	logText.SetFocusable(true) // Focus the log pane to scroll it with the arrow keys
 
 	selectableGrid := tinytui.NewGrid()
 	gridData := [][]string{
@@ -142,6 +143,7 @@ func main() {
 		{"Option 4", "Value D"}, {"Long Option 5", "Value E"},
 	}
 	selectableGrid.SetCells(gridData)
+	selectableGrid.SetColumnWidths([]int{18, 10}) // Fit "Long Option 5" without widening the value column
 	selectableGrid.SetSelectionMode(tinytui.MultiSelect)
 	selectableGrid.SetIndicator('*', true)
 
@@ -248,7 +250,6 @@ func main() {
 		name := nameInput.GetText()
 		appLog("Submit button pressed! Name: %s", name)
 		updateStatus("Submitted: " + name)
-		submitButton.SetCellInteracted(r, c, false)
 		app.Dispatch(&tinytui.FocusCommand{Target: nameInput})
 	})
 
@@ -286,8 +287,7 @@ func main() {
 
 	// Handler for the Theme Button Grid uses the function defined above
 	themeButton.SetOnSelect(func(r, c int, i string) {
-		switchThemeFunc()                          // Call the common logic
-		themeButton.SetCellInteracted(r, c, false) // Deselect button visually
+		switchThemeFunc() // Call the common logic
 	})
 
 	selectableGrid.SetOnChange(func(row, col int, item string) {
@@ -330,7 +330,7 @@ func main() {
 	appLog("Application initialized.")
 	updateStatus("Ready.")
 	app.Dispatch(&tinytui.FocusCommand{Target: nameInput}) // Start focus in the input field
-	updateSprite()                                         // Start sprite animation goroutine
+	updateSprite()                                         // Start the sprite animation task
 
 	// --- Run Application ---
 	log.Println("Running application event loop...")
@@ -0,0 +1,52 @@
+// main.go
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LixenWraith/tinytui"
+	"github.com/gdamore/tcell/v2"
+)
+
+func main() {
+	// --- Application Setup ---
+	app := tinytui.NewApplication()
+	app.SetScreenMode(tinytui.ScreenAlternate)
+
+	// --- Create Components ---
+	header := tinytui.NewText("")
+	header.SetAlignment(tinytui.AlignTextCenter)
+	setHeader := func() {
+		header.SetContent(fmt.Sprintf("Theme: %s - [F2] Next Theme | [Tab] Cycle Focus | [Esc] Quit", app.GetTheme().Name()))
+	}
+	setHeader()
+
+	headerPane := tinytui.NewPane()
+	headerPane.SetBorder(tinytui.BorderNone, tinytui.DefaultPaneBorderStyle())
+	headerPane.SetChild(header)
+
+	galleryPane := tinytui.NewPane()
+	galleryPane.SetTitle("Theme Gallery")
+	galleryPane.SetChild(tinytui.BuildThemePreview())
+
+	// --- Setup Layout ---
+	rootLayout := tinytui.NewLayout(tinytui.Vertical)
+	rootLayout.AddPane(headerPane, tinytui.Size{FixedSize: 1})
+	rootLayout.AddPane(galleryPane, tinytui.Size{Proportion: 1})
+	app.SetLayout(rootLayout)
+
+	// --- Theme Switching ---
+	app.RegisterKeyHandler(tcell.KeyF2, tcell.ModNone, func() bool {
+		tinytui.NextTheme()              // Advance the global theme
+		app.SetTheme(tinytui.GetTheme()) // Restyle the component tree
+		setHeader()
+		return true
+	})
+
+	// --- Run Application ---
+	if err := app.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
+		os.Exit(1)
+	}
+}
\ No newline at end of file
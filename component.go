@@ -7,6 +7,11 @@ import (
 
 // Component is the fundamental interface for all visual elements within a Pane.
 // It defines methods for drawing, geometry management, event handling, focus, visibility, and state.
+//
+// Custom components should embed BaseComponent, which provides working defaults for every
+// method (including ThemedComponent's ApplyTheme), and override only what they need:
+// usually Draw, plus HandleEvent for interactive widgets. The default Focusable reports any
+// visible component as focusable, so display-only widgets override it to return false.
 type Component interface {
 	// Draw renders the component onto the screen within its allocated rectangle.
 	// Implementations should respect the component's visibility and bounds.
@@ -75,6 +80,17 @@ type Component interface {
 	// ClearDirty resets the dirty flag. Called by the application after drawing.
 	// Containers should override this to clear flags recursively.
 	ClearDirty()
+
+	// KeyHints returns the keys the component responds to while focused, for display
+	// in a context-sensitive hint bar (see Application.FocusedKeyHints). May be empty.
+	KeyHints() []KeyHint
+}
+
+// KeyHint describes a key (or key group) a component responds to and what it does,
+// e.g., {Key: "Enter", Description: "submit"}.
+type KeyHint struct {
+	Key         string // Human-readable key label (e.g., "Enter", "←/→", "Ctrl+E")
+	Description string // Short description of the action
 }
 
 // TextUpdater is an optional interface for components whose primary content
@@ -94,4 +110,61 @@ type ThemedComponent interface {
 	// ApplyTheme updates the component's appearance (e.g., internal styles)
 	// based on the properties of the provided theme.
 	ApplyTheme(theme Theme)
-}
\ No newline at end of file
+}
+
+// Constrained is an optional interface for components that have a minimum usable size.
+// Layouts will not shrink a pane below its child's minimum (plus border) while space allows;
+// when the terminal is too small to honor every minimum, the layout shows an overflow indicator.
+type Constrained interface {
+	Component
+	// MinSize returns the smallest width and height (in cells) at which the component renders correctly.
+	MinSize() (width, height int)
+}
+
+// Measurable is an optional interface for components that can report the size they need to show
+// all of their content without truncation or scrolling. Layout.ComputePreferredSize uses it to
+// measure a layout built from such components, e.g., to size a popup to its content.
+type Measurable interface {
+	Component
+	// PreferredSize returns the width and height (in cells) that fit the component's content.
+	PreferredSize() (width, height int)
+}
+
+// FormField is an optional interface for input components whose value can be tracked for changes,
+// e.g., to enable a Save button only when something was edited. See Application.RegisterFormField.
+type FormField interface {
+	Component
+	// SetBaseline records the component's current value as the unmodified state.
+	SetBaseline()
+	// IsModified reports whether the current value differs from the recorded baseline.
+	IsModified() bool
+}
+
+// Compile-time checks that BaseComponent and the built-in components satisfy the public interfaces.
+var (
+	_ ThemedComponent = (*BaseComponent)(nil)
+	_ ThemedComponent = (*Text)(nil)
+	_ ThemedComponent = (*TextInput)(nil)
+	_ ThemedComponent = (*Grid)(nil)
+	_ ThemedComponent = (*Sprite)(nil)
+	_ ThemedComponent = (*ButtonRow)(nil)
+	_ ThemedComponent = (*Form)(nil)
+	_ ThemedComponent = (*SplitPane)(nil)
+	_ ThemedComponent = (*Steps)(nil)
+	_ TextUpdater     = (*Text)(nil)
+	_ TextUpdater     = (*TextInput)(nil)
+	_ TextUpdater     = (*Grid)(nil)
+	_ TextUpdater     = (*Sprite)(nil)
+	_ FormField       = (*TextInput)(nil)
+	_ FormField       = (*Grid)(nil)
+	_ FormField       = (*Form)(nil)
+	_ Constrained     = (*Grid)(nil)
+	_ Constrained     = (*ButtonRow)(nil)
+	_ Constrained     = (*Form)(nil)
+	_ Constrained     = (*SplitPane)(nil)
+	_ Constrained     = (*Steps)(nil)
+	_ Measurable      = (*Text)(nil)
+	_ Measurable      = (*Grid)(nil)
+	_ Measurable      = (*ButtonRow)(nil)
+	_ Measurable      = (*Steps)(nil)
+)
\ No newline at end of file
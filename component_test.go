@@ -0,0 +1,109 @@
+// component_test.go
+package tinytui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// counter is a minimal custom widget: it embeds BaseComponent and overrides only Draw and HandleEvent.
+type counter struct {
+	BaseComponent
+	count int
+}
+
+func newCounter() *counter {
+	return &counter{BaseComponent: NewBaseComponent()}
+}
+
+func (c *counter) Draw(screen tcell.Screen) {
+	x, y, _, _ := c.GetRect()
+	for i, r := range fmt.Sprintf("n=%d", c.count) {
+		screen.SetContent(x+i, y, r, nil, tcell.StyleDefault)
+	}
+}
+
+func (c *counter) HandleEvent(event tcell.Event) bool {
+	if ev, ok := event.(*tcell.EventKey); ok && ev.Key() == tcell.KeyEnter {
+		c.count++
+		c.MarkDirty()
+		return true
+	}
+	return false
+}
+
+var (
+	_ Component       = (*counter)(nil)
+	_ ThemedComponent = (*counter)(nil)
+)
+
+// screenText reads width cells of row y from the screen as a string.
+func screenText(screen tcell.Screen, x, y, width int) string {
+	runes := make([]rune, 0, width)
+	for i := 0; i < width; i++ {
+		r, _, _, _ := screen.GetContent(x+i, y)
+		runes = append(runes, r)
+	}
+	return string(runes)
+}
+
+func TestCustomComponentInLayout(t *testing.T) {
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("simulation screen: %v", err)
+	}
+	t.Cleanup(screen.Fini)
+	screen.SetSize(40, 5)
+
+	app := NewApplication()
+	app.screen = screen
+	widget := newCounter()
+	pane := NewPane()
+	pane.SetChild(widget)
+	layout := NewLayout(Horizontal)
+	layout.AddPane(pane, Size{Proportion: 1})
+	app.SetLayout(layout)
+
+	if widget.App() != app {
+		t.Error("widget did not receive the application reference")
+	}
+	app.SetFocus(widget) // The inherited Focusable reports visible widgets as focusable
+	if app.GetFocusedComponent() != widget {
+		t.Fatal("widget did not take focus")
+	}
+
+	app.draw()
+	x, y, w, h := widget.GetRect()
+	if w <= 0 || h <= 0 {
+		t.Fatalf("widget rect %dx%d, want a non-empty area", w, h)
+	}
+	if got := screenText(screen, x, y, 3); got != "n=0" {
+		t.Errorf("first draw shows %q, want %q", got, "n=0")
+	}
+	if widget.IsDirty() {
+		t.Error("widget still dirty after draw")
+	}
+
+	app.ProcessEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone)) // Routed to the focused widget
+	if widget.count != 1 {
+		t.Errorf("count %d after Enter, want 1", widget.count)
+	}
+	if widget.HandleEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)) {
+		t.Error("Tab reported as handled")
+	}
+	if !widget.IsDirty() {
+		t.Error("widget not dirty after handling Enter")
+	}
+	app.draw()
+	if got := screenText(screen, x, y, 3); got != "n=1" {
+		t.Errorf("redraw shows %q, want %q", got, "n=1")
+	}
+
+	// The inherited ApplyTheme marks the widget dirty when the theme changes
+	app.SetTheme(NewDefaultTheme())
+	if !widget.IsDirty() {
+		t.Error("theme change did not mark the widget dirty")
+	}
+}
\ No newline at end of file
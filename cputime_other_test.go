@@ -0,0 +1,11 @@
+// cputime_other_test.go
+//go:build !unix
+
+package tinytui
+
+import "time"
+
+// processCPUTime reports that CPU time is not available on this platform.
+func processCPUTime() (time.Duration, bool) {
+	return 0, false
+}
\ No newline at end of file
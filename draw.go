@@ -234,4 +234,55 @@ func DrawText(screen tcell.Screen, x, y int, style Style, text string) {
 
 		currentX += runeWidth // Advance by the rune's width
 	}
+}
+
+// Arm bits describing which directions a box-drawing rune extends towards.
+const (
+	boxArmUp    uint8 = 1 << iota // Line leaves the cell upwards
+	boxArmDown                    // Line leaves the cell downwards
+	boxArmLeft                    // Line leaves the cell to the left
+	boxArmRight                   // Line leaves the cell to the right
+)
+
+// singleBoxRunes maps arm combinations to single-line box-drawing runes (including junctions).
+var singleBoxRunes = map[uint8]rune{
+	boxArmLeft | boxArmRight:                         RuneHLine,
+	boxArmUp | boxArmDown:                            RuneVLine,
+	boxArmDown | boxArmRight:                         RuneULCorner,
+	boxArmDown | boxArmLeft:                          RuneURCorner,
+	boxArmUp | boxArmRight:                           RuneLLCorner,
+	boxArmUp | boxArmLeft:                            RuneLRCorner,
+	boxArmUp | boxArmDown | boxArmRight:              '├',
+	boxArmUp | boxArmDown | boxArmLeft:               '┤',
+	boxArmDown | boxArmLeft | boxArmRight:            '┬',
+	boxArmUp | boxArmLeft | boxArmRight:              '┴',
+	boxArmUp | boxArmDown | boxArmLeft | boxArmRight: '┼',
+}
+
+// doubleBoxRunes maps arm combinations to double-line box-drawing runes (including junctions).
+var doubleBoxRunes = map[uint8]rune{
+	boxArmLeft | boxArmRight:                         RuneDoubleHLine,
+	boxArmUp | boxArmDown:                            RuneDoubleVLine,
+	boxArmDown | boxArmRight:                         RuneDoubleULCorner,
+	boxArmDown | boxArmLeft:                          RuneDoubleURCorner,
+	boxArmUp | boxArmRight:                           RuneDoubleLLCorner,
+	boxArmUp | boxArmLeft:                            RuneDoubleLRCorner,
+	boxArmUp | boxArmDown | boxArmRight:              '╠',
+	boxArmUp | boxArmDown | boxArmLeft:               '╣',
+	boxArmDown | boxArmLeft | boxArmRight:            '╦',
+	boxArmUp | boxArmLeft | boxArmRight:              '╩',
+	boxArmUp | boxArmDown | boxArmLeft | boxArmRight: '╬',
+}
+
+// boxRuneArms returns the arms of a single or double line box-drawing rune and the
+// rune set it belongs to. Returns a nil set for any other rune.
+func boxRuneArms(r rune) (arms uint8, set map[uint8]rune) {
+	for _, candidate := range []map[uint8]rune{singleBoxRunes, doubleBoxRunes} {
+		for a, br := range candidate {
+			if br == r {
+				return a, candidate
+			}
+		}
+	}
+	return 0, nil
 }
\ No newline at end of file
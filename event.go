@@ -2,6 +2,9 @@
 package tinytui
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -96,11 +99,12 @@ func (c *FindNextFocusCommand) Execute(app *Application) {
 		app.focusedComponent = nil // Directly set to nil, avoid calling SetFocus(nil)
 	}
 
-	// Find the first available focusable component in the entire layout.
+	// Find the nearest focusable component following the origin in the focus order
+	// (the first one in the layout if the origin's position is unknown).
 	if app.layout != nil {
 		focusables := app.layout.GetAllFocusableComponents()
 		if len(focusables) > 0 {
-			app.SetFocus(focusables[0]) // Set focus to the first one found
+			app.SetFocus(focusables[app.nearestFocusIndex(focusables, c.origin, true)])
 		}
 		// If no focusable components are left, focus remains nil.
 	}
@@ -164,6 +168,37 @@ type KeyModCombo struct {
 	Mod tcell.ModMask // The modifier mask (e.g., tcell.ModAlt, tcell.ModCtrl).
 }
 
+// Matches reports whether the key event corresponds to this key + modifier combination.
+// For rune keys (tcell.KeyRune) use RegisterRuneHandler instead; only Key and Mod are compared.
+func (c KeyModCombo) Matches(ev *tcell.EventKey) bool {
+	return ev != nil && ev.Key() == c.Key && ev.Modifiers() == c.Mod
+}
+
+// String returns a human-readable name for the combination (e.g., "Enter", "Alt+Enter", "Ctrl+R"),
+// suitable for key hints.
+func (c KeyModCombo) String() string {
+	name, ok := tcell.KeyNames[c.Key]
+	if !ok {
+		name = "Key(" + strconv.Itoa(int(c.Key)) + ")"
+	}
+	name = strings.Replace(name, "Ctrl-", "Ctrl+", 1) // Control keys are named "Ctrl-X" by tcell
+
+	prefix := ""
+	if c.Mod&tcell.ModCtrl != 0 && !strings.HasPrefix(name, "Ctrl+") {
+		prefix += "Ctrl+"
+	}
+	if c.Mod&tcell.ModAlt != 0 {
+		prefix += "Alt+"
+	}
+	if c.Mod&tcell.ModMeta != 0 {
+		prefix += "Meta+"
+	}
+	if c.Mod&tcell.ModShift != 0 {
+		prefix += "Shift+"
+	}
+	return prefix + name
+}
+
 // KeyHandler defines the function signature for handling registered key events (non-rune or specific runes).
 // It should return true if the key event was handled (consumed), false otherwise.
 type KeyHandler func() bool
\ No newline at end of file
@@ -0,0 +1,413 @@
+// form.go
+package tinytui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// formRow is a single labeled field within a Form.
+type formRow struct {
+	label string    // Text shown to the left of the field
+	field Component // Input component occupying the rest of the row
+}
+
+// Form arranges labeled input components in rows: each label is drawn on the left in a
+// column of configurable width and its field fills the remaining width to the right.
+// The form is a single focusable component; Tab/Shift+Tab move between its focusable fields
+// in the order they were added, and all other events go to the field holding focus. Clicking a
+// field (or its label) moves focus to it.
+// Tab past the last field (or Shift+Tab before the first) releases focus normally.
+type Form struct {
+	BaseComponent
+	rows            []formRow // Rows in display and tab order
+	current         int       // Index of the row whose field has focus within the form (-1 if none)
+	labelWidth      int       // Width of the label column (0 = fit the widest label)
+	labelGap        int       // Cells between the label column and the fields
+	style           Style     // Style for labels and the background
+	focusLabelStyle Style     // Style for the label of the current field while the form has focus
+	mouseDown       bool      // Button 1 is held after a press (drag and release go to the current field)
+}
+
+// NewForm creates an empty form with an automatically sized label column.
+func NewForm() *Form {
+	theme := GetTheme()
+	if theme == nil {
+		theme = NewDefaultTheme()
+	} // Fallback
+
+	f := &Form{
+		BaseComponent: NewBaseComponent(),
+		rows:          make([]formRow, 0),
+		current:       -1,
+		labelWidth:    0, // Auto
+		labelGap:      1,
+	}
+	f.ApplyTheme(theme)
+	return f
+}
+
+// ApplyTheme updates the label styles and applies the theme to every themed field.
+// Implements ThemedComponent.
+func (f *Form) ApplyTheme(theme Theme) {
+	if theme == nil {
+		return
+	}
+	f.style = theme.TextStyle()
+	f.focusLabelStyle = theme.TextSelectedStyle()
+	for _, row := range f.rows {
+		if themed, ok := row.field.(ThemedComponent); ok {
+			themed.ApplyTheme(theme)
+		}
+	}
+	f.MarkDirty()
+}
+
+// SetApplication links the form and all of its fields to the application.
+func (f *Form) SetApplication(app *Application) {
+	f.BaseComponent.SetApplication(app)
+	for _, row := range f.rows {
+		row.field.SetApplication(app)
+	}
+}
+
+// AddField appends a row showing label on the left and field on the right.
+// Returns the row's index. The first focusable field added receives focus within the form.
+func (f *Form) AddField(label string, field Component) int {
+	if field == nil {
+		return -1
+	}
+	if app := f.App(); app != nil {
+		field.SetApplication(app)
+		if themed, ok := field.(ThemedComponent); ok {
+			themed.ApplyTheme(app.GetTheme())
+		}
+	}
+	f.rows = append(f.rows, formRow{label: label, field: field})
+	index := len(f.rows) - 1
+	if f.current < 0 && field.Focusable() {
+		f.current = index
+		if f.IsFocused() {
+			field.Focus()
+		}
+	}
+	f.layoutRows()
+	f.MarkDirty()
+	return index
+}
+
+// Field returns the field added with the given label, or nil if there is none.
+func (f *Form) Field(label string) Component {
+	for _, row := range f.rows {
+		if row.label == label {
+			return row.field
+		}
+	}
+	return nil
+}
+
+// Values returns the current value of each field keyed by its label.
+// Values are read from the known field types: TextInput (text), Text (content) and
+// Grid (content of the selected cell). Other components are omitted.
+func (f *Form) Values() map[string]string {
+	values := make(map[string]string, len(f.rows))
+	for _, row := range f.rows {
+		switch field := row.field.(type) {
+		case *TextInput:
+			values[row.label] = field.GetText()
+		case *Text:
+			values[row.label] = field.GetContent()
+		case *Grid:
+			_, _, values[row.label] = field.GetSelectedCell()
+		}
+	}
+	return values
+}
+
+// SetLabelWidth sets the width of the label column. A width of 0 fits the widest label.
+func (f *Form) SetLabelWidth(width int) {
+	if width < 0 {
+		width = 0
+	}
+	if f.labelWidth != width {
+		f.labelWidth = width
+		f.layoutRows()
+		f.MarkDirty()
+	}
+}
+
+// effectiveLabelWidth returns the configured label width, or the widest label when set to auto.
+func (f *Form) effectiveLabelWidth() int {
+	if f.labelWidth > 0 {
+		return f.labelWidth
+	}
+	widest := 0
+	for _, row := range f.rows {
+		widest = max(widest, runewidth.StringWidth(row.label))
+	}
+	return widest
+}
+
+// rowHeight returns the number of lines given to a row: the field's minimum height if it
+// implements Constrained, otherwise a single line.
+func rowHeight(field Component) int {
+	if constrained, ok := field.(Constrained); ok {
+		if _, h := constrained.MinSize(); h > 1 {
+			return h
+		}
+	}
+	return 1
+}
+
+// SetRect sets the form's position and size and lays out its rows.
+func (f *Form) SetRect(x, y, width, height int) {
+	f.BaseComponent.SetRect(x, y, width, height)
+	f.layoutRows()
+}
+
+// layoutRows positions every field to the right of the label column, stacking rows top to bottom.
+// Rows that do not fit within the form's rectangle are given a zero size.
+func (f *Form) layoutRows() {
+	x, y, width, height := f.GetRect()
+	fieldX := x + f.effectiveLabelWidth() + f.labelGap
+	fieldWidth := max(x+width-fieldX, 0)
+
+	rowY := y
+	for _, row := range f.rows {
+		h := min(rowHeight(row.field), max(y+height-rowY, 0))
+		if fieldWidth == 0 || h == 0 {
+			row.field.SetRect(fieldX, rowY, 0, 0)
+			continue
+		}
+		row.field.SetRect(fieldX, rowY, fieldWidth, h)
+		rowY += h
+	}
+}
+
+// MinSize returns the space needed for the label column, the widest field minimum and all rows.
+// Implements Constrained.
+func (f *Form) MinSize() (width, height int) {
+	fieldWidth := 1
+	for _, row := range f.rows {
+		if constrained, ok := row.field.(Constrained); ok {
+			w, _ := constrained.MinSize()
+			fieldWidth = max(fieldWidth, w)
+		}
+		height += rowHeight(row.field)
+	}
+	return f.effectiveLabelWidth() + f.labelGap + fieldWidth, height
+}
+
+// Focusable returns true when the form is visible and has at least one focusable field.
+func (f *Form) Focusable() bool {
+	if !f.IsVisible() {
+		return false
+	}
+	for _, row := range f.rows {
+		if row.field.Focusable() {
+			return true
+		}
+	}
+	return false
+}
+
+// Focus gives focus to the form and to its current field.
+func (f *Form) Focus() {
+	f.BaseComponent.Focus()
+	if f.current < 0 || f.current >= len(f.rows) || !f.rows[f.current].field.Focusable() {
+		f.current = f.nextFocusable(-1, 1)
+	}
+	if f.current >= 0 {
+		f.rows[f.current].field.Focus()
+	}
+}
+
+// Blur removes focus from the form and its current field.
+func (f *Form) Blur() {
+	f.BaseComponent.Blur()
+	if f.current >= 0 && f.current < len(f.rows) {
+		f.rows[f.current].field.Blur()
+	}
+}
+
+// nextFocusable returns the index of the next focusable row after from in the given direction, or -1.
+func (f *Form) nextFocusable(from, direction int) int {
+	for i := from + direction; i >= 0 && i < len(f.rows); i += direction {
+		if f.rows[i].field.Focusable() {
+			return i
+		}
+	}
+	return -1
+}
+
+// moveFocus moves focus within the form to the next focusable field in the given direction.
+// Returns false (leaving focus unchanged) when there is no such field.
+func (f *Form) moveFocus(direction int) bool {
+	next := f.nextFocusable(f.current, direction)
+	if next < 0 {
+		return false
+	}
+	if f.current >= 0 && f.current < len(f.rows) {
+		f.rows[f.current].field.Blur()
+	}
+	f.current = next
+	f.rows[next].field.Focus()
+	f.MarkDirty()
+	return true
+}
+
+// rowAt returns the index of the row at screen position (x, y), label included, or -1.
+func (f *Form) rowAt(x, y int) int {
+	fx, _, width, _ := f.GetRect()
+	if x < fx || x >= fx+width {
+		return -1
+	}
+	for i, row := range f.rows {
+		_, rowY, _, h := row.field.GetRect()
+		if y >= rowY && y < rowY+h {
+			return i
+		}
+	}
+	return -1
+}
+
+// handleMouse makes the field under a left press current (a press on a label selects its field)
+// and forwards the press to it. Drag motion and the release go to the current field, which
+// received the press; wheel and hover events go to the field under the pointer.
+func (f *Form) handleMouse(ev *tcell.EventMouse) bool {
+	held := ev.Buttons()&tcell.Button1 != 0
+	wasDown := f.mouseDown
+	f.mouseDown = held
+	if wasDown && f.current >= 0 && f.current < len(f.rows) {
+		return f.rows[f.current].field.HandleEvent(ev) // Drag or release
+	}
+
+	mx, my := ev.Position()
+	index := f.rowAt(mx, my)
+	if index < 0 {
+		return false
+	}
+	field := f.rows[index].field
+	if held && index != f.current && field.Focusable() {
+		if f.current >= 0 && f.current < len(f.rows) {
+			f.rows[f.current].field.Blur()
+		}
+		f.current = index
+		field.Focus()
+		f.MarkDirty()
+	}
+	if componentContains(field, mx, my) {
+		return field.HandleEvent(ev) || held
+	}
+	return held // A press on a label only moves focus
+}
+
+// KeyHints returns the current field's hints followed by the form's own navigation keys.
+func (f *Form) KeyHints() []KeyHint {
+	var hints []KeyHint
+	if f.current >= 0 && f.current < len(f.rows) {
+		hints = append(hints, f.rows[f.current].field.KeyHints()...)
+	}
+	return append(hints, KeyHint{Key: "Tab/Shift+Tab", Description: "next/previous field"})
+}
+
+// SetBaseline records the current value of every field implementing FormField.
+// Implements FormField.
+func (f *Form) SetBaseline() {
+	for _, row := range f.rows {
+		if field, ok := row.field.(FormField); ok {
+			field.SetBaseline()
+		}
+	}
+}
+
+// IsModified reports whether any field implementing FormField differs from its baseline.
+// Implements FormField.
+func (f *Form) IsModified() bool {
+	for _, row := range f.rows {
+		if field, ok := row.field.(FormField); ok && field.IsModified() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDirty returns true if the form or any of its fields needs redrawing.
+func (f *Form) IsDirty() bool {
+	if f.BaseComponent.IsDirty() {
+		return true
+	}
+	for _, row := range f.rows {
+		if row.field.IsDirty() {
+			return true
+		}
+	}
+	return false
+}
+
+// ClearDirty clears the dirty flags of the form and all of its fields.
+func (f *Form) ClearDirty() {
+	f.BaseComponent.ClearDirty()
+	for _, row := range f.rows {
+		row.field.ClearDirty()
+	}
+}
+
+// Draw renders the labels and the fields of all rows that fit within the form's rectangle.
+func (f *Form) Draw(screen tcell.Screen) {
+	if !f.IsVisible() {
+		return
+	}
+
+	x, y, width, height := f.GetRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	Fill(screen, x, y, width, height, ' ', f.style)
+
+	labelWidth := min(f.effectiveLabelWidth(), width)
+	isFocused := f.IsFocused()
+	for i, row := range f.rows {
+		_, rowY, _, rowH := row.field.GetRect()
+		if rowH <= 0 {
+			continue // Row did not fit
+		}
+		style := f.style
+		if i == f.current && isFocused {
+			style = f.focusLabelStyle
+		}
+		if labelWidth > 0 {
+			DrawText(screen, x, rowY, style, runewidth.Truncate(row.label, labelWidth, "…"))
+		}
+		if row.field.IsVisible() {
+			drawComponent(screen, row.field)
+		}
+	}
+}
+
+// HandleEvent passes events to the focused field first; unhandled Tab/Shift+Tab move between fields.
+// Mouse events are routed by position (see handleMouse).
+func (f *Form) HandleEvent(event tcell.Event) bool {
+	if mouseEvent, ok := event.(*tcell.EventMouse); ok {
+		return f.handleMouse(mouseEvent)
+	}
+
+	if f.current >= 0 && f.current < len(f.rows) {
+		if f.rows[f.current].field.HandleEvent(event) {
+			return true
+		}
+	}
+
+	keyEvent, ok := event.(*tcell.EventKey)
+	if !ok {
+		return false
+	}
+	switch keyEvent.Key() {
+	case tcell.KeyTab:
+		return f.moveFocus(1) // Let the app move focus on from the last field
+	case tcell.KeyBacktab:
+		return f.moveFocus(-1) // Let the app move focus back from the first field
+	}
+	return false
+}
\ No newline at end of file
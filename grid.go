@@ -3,8 +3,11 @@ package tinytui
 
 import (
 	"fmt"
-	// NOTE: Removed strconv import as Sscanf is used instead
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/mattn/go-runewidth"
@@ -22,6 +25,35 @@ type Grid struct {
 	topRow          int             // Index of the top-most visible row (for scrolling)
 	leftCol         int             // Index of the left-most visible column (for scrolling)
 	padding         int             // Padding within cells (usually left/right)
+	columnWidths    map[int]int     // Per-column width overrides (key: column index)
+	resizingCol     int             // Column whose right boundary is being dragged with the mouse (-1 if none)
+	resizeClickRow  int             // Row of a headerless grip press, clicked if released unmoved (-1 if none)
+	mouseDown       bool            // Is the left button held since a press on the grid?
+	contentWidth    int             // Widest cell or header title, for auto width (-1 = not measured yet)
+	loading         bool            // Is the grid waiting for data (shows placeholder, ignores navigation)?
+	loadingText     string          // Placeholder text shown while loading
+	loadingSpinner  bool            // Show an animated spinner next to the loading text?
+	spinnerFrame    int             // Current spinner animation frame
+	spinnerTimer    *time.Timer     // Pending spinner animation tick (nil if not animating)
+	matches         [][2]int        // Cells found by the last Find, in row-major order
+	highlighted     map[string]bool // Cells drawn with highlightStyle (key: "row:col")
+	highlightStyle  Style           // Style for highlighted cells (layered under selection/interaction)
+	highlightCustom bool            // Was highlightStyle set explicitly (vs. following the theme)?
+	baseline        map[string]bool // Interacted cells recorded by SetBaseline, for IsModified
+	markedRow       int             // Row of the marked (secondary highlight) cell (-1 if none)
+	markedCol       int             // Column of the marked cell (-1 if none)
+	markedStyle     Style           // Style for the marked cell (layered under selection/interaction)
+	markedCustom    bool            // Was markedStyle set explicitly (vs. following the theme)?
+	emptyText       string          // Message shown centered while the grid has no cells ("" = blank)
+	header          []string        // Column titles drawn above the body (nil = no header row)
+	headerStyle     Style           // Style for the header row
+	headerCustom    bool            // Was headerStyle set explicitly (vs. following the theme)?
+	rowNumbers      bool            // Draw 1-based row numbers in a gutter left of the first column?
+	rowNumberStyle  Style           // Style for the row number gutter
+	rowNumberCustom bool            // Was rowNumberStyle set explicitly (vs. following the theme)?
+
+	cellStyles  map[string]Style              // Per-cell style overrides (key: "row:col")
+	comparators map[int]func(a, b string) int // Per-column comparators for SortByColumn (key: column index)
 
 	// Styles for different states (updated by ApplyTheme)
 	style                  Style
@@ -32,15 +64,62 @@ type Grid struct {
 	focusedInteractedStyle Style
 
 	// Event handlers
-	onChange func(row, col int, item string) // Called when selection changes
-	onSelect func(row, col int, item string) // Called when Enter/Space is pressed on a cell
+	onChange      func(row, col int, item string)                  // Called when selection changes
+	onSelect      func(row, col int, item string)                  // Called when Enter/Space is pressed on a cell
+	onToggle      func(row, col int, item string, interacted bool) // Called after Enter/Space with the cell's new interacted state
+	onCellEnter   func(row, col int)                               // Called when the cursor enters a cell
+	onCellLeave   func(row, col int)                               // Called when the cursor leaves a cell (before entering the next)
+	onCellFocus   func(row, col int, fullContent string)           // Called with the untruncated content of each newly selected cell
+	onConfirm     func(cells [][2]int)                             // Called with all interacted cells when the confirm key is pressed (MultiSelect)
+	onEdit        func(row, col int, oldValue, newValue string)    // Called for each cell changed by cut or paste
+	onScroll      func(top, bottom int)                            // Called when the visible row range or left column changes
+	onBulkToggle  func(cells [][2]int, interacted bool)            // Called once after ToggleRow/ToggleColumn with the changed cells
+	onReachTop    func()                                           // Called when vertical navigation reaches the first row
+	onReachBottom func()                                           // Called when vertical navigation reaches the last row
 
 	// Configuration
-	selectionMode  SelectionMode // Single or Multi selection
-	autoWidth      bool          // Calculate width based on content?
-	showIndicator  bool          // Show indicator on the selected cell?
-	indicatorChar  rune          // Character used for selection indicator
-	indicatorStyle Style         // Style for the indicator (derived from theme)
+	selectionMode   SelectionMode // Single or Multi selection
+	selectOnFocus   SelectOnFocus // Selection behavior when the grid gains focus
+	activateKeys    []tcell.Key   // Non-rune keys that activate (select/toggle) the selected cell
+	activateRunes   []rune        // Runes that activate the selected cell
+	autoWidth       bool          // Calculate width based on content?
+	fitWidth        bool          // Stretch columns evenly to fill the grid's width?
+	showIndicator   bool          // Show indicator on the selected cell?
+	indicatorChar   rune          // Character used for selection indicator
+	indicatorStyle  Style         // Style for the indicator (derived from theme)
+	confirmKey      KeyModCombo   // Key that confirms a multi-selection (when onConfirm is set)
+	clipboard       bool          // Handle Ctrl+C / Ctrl+X / Ctrl+V as copy / cut / paste?
+	buttonMode      bool          // Activation only fires onSelect, without toggling the interacted state?
+	advanceOnSelect bool          // Move the selection to the next cell after activation?
+	scrollBar       bool          // Draw a vertical scrollbar when rows overflow?
+	scrollBarAuto   bool          // Only show the scrollbar while scrolling or hovering?
+	gotoEnabled     bool          // Does the goto key open the "go to row" prompt?
+	gotoKey         rune          // Rune that opens the "go to row" prompt
+	cellTooltip     bool          // Overlay the full content of a truncated selected cell?
+	cellWrap        bool          // Word-wrap content over the lines of tall cells?
+	cellAlign       AlignmentText // Horizontal alignment of content within its cell (and of header titles)
+
+	rowKey func(row []string) string // Stable row key the selection follows across SetCells (nil = by index)
+
+	// Last scroll position reported to onScroll (top row, bottom row, left column)
+	scrollReported [3]int
+	scrollDetached bool // Was the view scrolled with SetTopRow, so it no longer follows the selection?
+
+	// Times onReachTop / onReachBottom last fired, to debounce held navigation keys
+	reachedTop    time.Time
+	reachedBottom time.Time
+
+	// Scrollbar styles (derived from theme)
+	scrollBarStyle      Style // Track
+	scrollBarThumbStyle Style // Thumb
+
+	// Scrollbar interaction state
+	scrollBarShown  bool        // Is an auto-hiding scrollbar currently revealed?
+	scrollBarTimer  *time.Timer // Pending auto-hide of the scrollbar (nil if none)
+	draggingThumb   bool        // Is the scrollbar thumb being dragged with the mouse?
+	thumbDragOffset int         // Offset of the pointer from the top of the thumb while dragging
+
+	gotoInput *TextInput // Open "go to row" prompt (nil if closed)
 }
 
 // NewGrid creates a new grid component, initializing styles from the current theme.
@@ -56,6 +135,17 @@ func NewGrid() *Grid {
 		selectedRow:     -1, // No selection initially
 		selectedCol:     -1,
 		interactedCells: make(map[string]bool),
+		columnWidths:    make(map[int]int),
+		highlighted:     make(map[string]bool),
+		cellStyles:      make(map[string]Style),
+		comparators:     make(map[int]func(a, b string) int),
+		markedRow:       -1,
+		markedCol:       -1,
+		resizingCol:     -1,
+		resizeClickRow:  -1,
+		contentWidth:    -1,
+		loadingText:     "Loading…",
+		loadingSpinner:  true,
 		cellWidth:       theme.DefaultCellWidth(),  // Use theme default
 		cellHeight:      theme.DefaultCellHeight(), // Use theme default
 		padding:         theme.DefaultPadding(),    // Use theme default
@@ -65,6 +155,11 @@ func NewGrid() *Grid {
 		selectionMode:   SingleSelect,
 		showIndicator:   true,
 		indicatorChar:   '>',
+		gotoKey:         ':',
+		confirmKey:      KeyModCombo{Key: tcell.KeyEnter, Mod: tcell.ModNone},
+		activateKeys:    []tcell.Key{tcell.KeyEnter},
+		activateRunes:   []rune{' '},
+		scrollReported:  [3]int{-1, -1, -1}, // Nothing reported yet
 		// Styles will be set by ApplyTheme
 	}
 	// Apply the initial theme
@@ -85,10 +180,24 @@ func (g *Grid) ApplyTheme(theme Theme) {
 	g.focusedStyle = theme.GridFocusedStyle()
 	g.focusedSelectedStyle = theme.GridFocusedSelectedStyle()
 	g.focusedInteractedStyle = theme.GridFocusedInteractedStyle()
+	if !g.highlightCustom {
+		g.highlightStyle = theme.HighlightStyle()
+	}
+	if !g.markedCustom {
+		g.markedStyle = markedStyleFor(theme)
+	}
+	if !g.headerCustom {
+		g.headerStyle = theme.GridHeaderStyle()
+	}
+	if !g.rowNumberCustom {
+		g.rowNumberStyle = theme.GridStyle().Dim(true)
+	}
 
 	// Use theme's indicator color combined with the focused selected style for the indicator
 	// This ensures the indicator is visible against the selected cell background
 	g.indicatorStyle = theme.GridFocusedSelectedStyle().Foreground(theme.IndicatorColor())
+	g.scrollBarStyle = theme.ScrollBarStyle()
+	g.scrollBarThumbStyle = theme.ScrollBarThumbStyle()
 
 	// Note: We don't automatically reset explicitly set dimensions/padding on theme change.
 	// The user might have customized them after creation.
@@ -98,9 +207,16 @@ func (g *Grid) ApplyTheme(theme Theme) {
 
 // SetCells updates the grid's content. Resets scroll and potentially selection.
 // Ensures the resulting grid data is rectangular by padding shorter rows.
+// Clears the loading state, if set, since the awaited data has arrived.
 func (g *Grid) SetCells(cells [][]string) {
+	g.SetLoading(false)
+
 	prevRow, prevCol := g.selectedRow, g.selectedCol
 	hadSelection := prevRow >= 0 && prevCol >= 0
+	prevKey, keyed := "", false // Key of the selected row, to find it again in the new data
+	if g.rowKey != nil && hadSelection && prevRow < len(g.cells) {
+		prevKey, keyed = g.rowKey(g.cells[prevRow]), true
+	}
 
 	// Ensure grid is rectangular (pad shorter rows if necessary) for predictability
 	maxCols := 0
@@ -121,17 +237,29 @@ func (g *Grid) SetCells(cells [][]string) {
 	} else {
 		g.cells = cells // Empty grid
 	}
+	g.contentWidth = -1 // Re-measure for auto width
 
 	numRows := len(g.cells)
 	numCols := maxCols // Use the calculated maxCols
 
-	// Reset scroll position
+	// Reset scroll position; the view follows the (possibly kept) selection again
 	g.topRow = 0
 	g.leftCol = 0
+	g.scrollDetached = false
 
 	// Reset selection or try to keep it
 	if numRows > 0 && numCols > 0 {
-		if hadSelection && prevRow < numRows && prevCol < numCols {
+		if keyed {
+			// Follow the selected row by key, or stay at the same index (clamped) if it's gone
+			g.selectedRow = min(prevRow, numRows-1)
+			for i, row := range g.cells {
+				if g.rowKey(row) == prevKey {
+					g.selectedRow = i
+					break
+				}
+			}
+			g.selectedCol = min(prevCol, numCols-1)
+		} else if hadSelection && prevRow < numRows && prevCol < numCols {
 			// Keep previous selection if still valid
 			g.selectedRow = prevRow
 			g.selectedCol = prevCol
@@ -146,13 +274,25 @@ func (g *Grid) SetCells(cells [][]string) {
 		g.selectedCol = -1
 	}
 
-	g.ClearInteractions()      // Clear interaction state when content changes
-	g.ensureSelectionVisible() // Ensure the new selection is visible
+	g.ClearInteractions()                 // Clear interaction state when content changes
+	g.matches = nil                       // Search results refer to the old content
+	g.highlighted = make(map[string]bool) // ...and so do highlights
+	g.cellStyles = make(map[string]Style) // ...and per-cell styles
+	g.markedRow, g.markedCol = -1, -1     // ...and the marked cell
+	g.ensureSelectionVisible()            // Ensure the new selection is visible
 	g.MarkDirty()
 
+	// An empty grid cannot keep focus
+	if numRows == 0 && g.IsFocused() && g.app != nil {
+		g.app.Dispatch(&FindNextFocusCommand{origin: g})
+	}
+
 	// Check if selection actually changed and trigger onChange
 	newRow, newCol := g.selectedRow, g.selectedCol
 	selectionChanged := (newRow != prevRow || newCol != prevCol)
+	if selectionChanged || !hadSelection {
+		g.notifyCellMove(prevRow, prevCol, newRow, newCol)
+	}
 	if selectionChanged && g.onChange != nil && newRow >= 0 && newCol >= 0 {
 		g.onChange(newRow, newCol, g.cells[newRow][newCol])
 	} else if !hadSelection && newRow >= 0 && newCol >= 0 && g.onChange != nil {
@@ -162,7 +302,8 @@ func (g *Grid) SetCells(cells [][]string) {
 }
 
 // SetContent implements TextUpdater by parsing a string into cells.
-// Expects newline ('\n') for row separation and tab ('\t') for column separation.
+// Expects newline ('\n') for row separation and tab ('\t') for column separation, so tabs are never
+// part of a cell's content (unlike Text, which expands tabs to tab stops; see Text.SetTabWidth).
 func (g *Grid) SetContent(content string) {
 	rowsData := [][]string{}
 	if len(content) == 0 {
@@ -225,6 +366,281 @@ func (g *Grid) SetAutoWidth(auto bool) {
 	}
 }
 
+// SetFitWidth enables or disables stretching columns evenly to fill the grid's width.
+// Columns with an explicit SetColumnWidth override keep their width; the rest share the remaining
+// space, with any remainder going to the left-most of them. The row number gutter and the
+// scrollbar column (when rows overflow) are not part of the space shared. Takes precedence over auto width.
+// If the columns would become too narrow to show content, the normal width is used and the grid scrolls.
+func (g *Grid) SetFitWidth(fit bool) {
+	if g.fitWidth != fit {
+		g.fitWidth = fit
+		g.ensureSelectionVisible()
+		g.MarkDirty()
+	}
+}
+
+// SetColumnWidth overrides the width of a single column, taking precedence over the
+// uniform cell width (fixed or auto). A width <= 0 removes the override so the column
+// falls back to the default width again. With the mouse enabled, users set overrides by dragging
+// a column's right boundary on the header row, or on the top visible row without a header.
+func (g *Grid) SetColumnWidth(col, width int) {
+	if col < 0 {
+		return
+	}
+	current, hasOverride := g.columnWidths[col]
+	if width <= 0 {
+		if hasOverride {
+			delete(g.columnWidths, col)
+			g.ensureSelectionVisible() // Column geometry changed, re-check scroll
+			g.MarkDirty()
+		}
+		return
+	}
+	if !hasOverride || current != width {
+		g.columnWidths[col] = width
+		g.ensureSelectionVisible()
+		g.MarkDirty()
+	}
+}
+
+// SetColumnWidths replaces all per-column width overrides at once: widths[i] becomes the width
+// of column i, as with SetColumnWidth. Columns without an entry, or with a width <= 0, fall back
+// to the default width. Pass nil to remove every override.
+func (g *Grid) SetColumnWidths(widths []int) {
+	g.columnWidths = make(map[int]int, len(widths))
+	for col, width := range widths {
+		if width > 0 {
+			g.columnWidths[col] = width
+		}
+	}
+	g.ensureSelectionVisible() // Column geometry changed, re-check scroll
+	g.MarkDirty()
+}
+
+// GetColumnWidth returns the effective width of the given column, taking per-column
+// overrides into account.
+func (g *Grid) GetColumnWidth(col int) int {
+	return g.columnWidth(col)
+}
+
+// SetCellAlignment sets how cell content is aligned horizontally within the space between the
+// cell's padding (after the selection indicator): AlignTextLeft (default), AlignTextCenter or
+// AlignTextRight, e.g., for numeric columns. Header titles are aligned the same way.
+func (g *Grid) SetCellAlignment(align AlignmentText) {
+	if g.cellAlign != align {
+		g.cellAlign = align
+		g.MarkDirty()
+	}
+}
+
+// alignedX returns where text textWidth cells wide starts when aligned within width cells at x.
+func alignedX(x, width, textWidth int, align AlignmentText) int {
+	switch align {
+	case AlignTextCenter:
+		return x + max(width-textWidth, 0)/2
+	case AlignTextRight:
+		return x + max(width-textWidth, 0)
+	}
+	return x
+}
+
+// SetCellWrap enables or disables multi-line cell content. When enabled and the cell height is
+// greater than 1, each cell's content is split at newlines and word-wrapped to the cell's width,
+// and up to cellHeight lines are drawn from the top of the cell; an ellipsis on the last line marks
+// content that didn't fit. Single-line cells are unaffected.
+func (g *Grid) SetCellWrap(wrap bool) {
+	if g.cellWrap != wrap {
+		g.cellWrap = wrap
+		g.MarkDirty()
+	}
+}
+
+// cellLines returns the lines to draw for content in a wrapped cell of the given size, and whether
+// anything was cut off. The last line ends with an ellipsis when lines were dropped.
+func cellLines(content string, width, height int) ([]string, bool) {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" {
+			lines = append(lines, "")
+			continue
+		}
+		for _, segment := range wrapLine(line, width) {
+			lines = append(lines, strings.TrimRight(segment, " "))
+		}
+	}
+	truncated := false
+	for i, line := range lines {
+		if runewidth.StringWidth(line) > width { // Unbreakable over-wide rune
+			lines[i] = runewidth.Truncate(line, width, "…")
+			truncated = true
+		}
+	}
+	if len(lines) > height {
+		last := strings.TrimRight(runewidth.Truncate(lines[height-1], width-1, ""), " ")
+		lines = append(lines[:height-1], last+"…")
+		truncated = true
+	}
+	return lines, truncated
+}
+
+// SetRowKeyFunc sets a function returning a stable key for a row (e.g., a file name or an ID
+// column), so the selection follows its row across SetCells: after a refresh, the row with the
+// previously selected row's key is selected again, and if it's gone the selection stays at the
+// same index, clamped to the new rows. Without a key function, the selection keeps its index if
+// still valid and otherwise returns to the first cell. Pass nil to remove the function.
+func (g *Grid) SetRowKeyFunc(key func(row []string) string) {
+	g.rowKey = key
+}
+
+// SetHeader sets column titles drawn in a row above the cells, one cell height tall, in the theme's
+// GridHeaderStyle. The header is aligned with the body's columns and scrolls horizontally with them,
+// but never scrolls vertically and can't be selected; the body starts below it. Pass nil to remove
+// the header.
+func (g *Grid) SetHeader(header []string) {
+	if header != nil {
+		header = append([]string{}, header...)
+	}
+	g.header = header
+	g.contentWidth = -1 // Titles count towards the auto width
+	g.MarkDirty()
+}
+
+// GetHeader returns a copy of the column titles (nil if no header is set).
+func (g *Grid) GetHeader() []string {
+	if g.header == nil {
+		return nil
+	}
+	return append([]string{}, g.header...)
+}
+
+// SetHeaderStyle sets the style of the header row. Pass DefaultStyle to follow the theme's
+// GridHeaderStyle again.
+func (g *Grid) SetHeaderStyle(style Style) {
+	g.headerCustom = style != DefaultStyle
+	if g.headerCustom {
+		g.headerStyle = style
+	} else {
+		theme := GetTheme()
+		if g.app != nil {
+			theme = g.app.GetTheme()
+		}
+		if theme == nil {
+			theme = NewDefaultTheme()
+		} // Fallback
+		g.headerStyle = theme.GridHeaderStyle()
+	}
+	g.MarkDirty()
+}
+
+// SetShowRowNumbers shows or hides a gutter left of the first column with each row's 1-based
+// number, right-aligned, as in editors and log viewers. The gutter is as wide as the largest row
+// number plus a space; it scrolls vertically with the rows but not horizontally, can't be selected,
+// and shifts the columns right by its width.
+func (g *Grid) SetShowRowNumbers(show bool) {
+	if g.rowNumbers != show {
+		g.rowNumbers = show
+		g.MarkDirty()
+	}
+}
+
+// SetRowNumberStyle sets the style of the row number gutter. Pass DefaultStyle to follow the theme again.
+func (g *Grid) SetRowNumberStyle(style Style) {
+	g.rowNumberCustom = style != DefaultStyle
+	if g.rowNumberCustom {
+		g.rowNumberStyle = style
+	} else {
+		theme := GetTheme()
+		if g.app != nil {
+			theme = g.app.GetTheme()
+		}
+		if theme == nil {
+			theme = NewDefaultTheme()
+		} // Fallback
+		g.rowNumberStyle = theme.GridStyle().Dim(true)
+	}
+	g.MarkDirty()
+}
+
+// gutterWidth returns the width of the row number gutter: the digits of the largest row number
+// plus a separating space, or 0 if row numbers are off.
+func (g *Grid) gutterWidth() int {
+	if !g.rowNumbers {
+		return 0
+	}
+	return len(strconv.Itoa(max(len(g.cells), 1))) + 1
+}
+
+// headerHeight returns the height of the header row: one cell height, or 0 without a header.
+func (g *Grid) headerHeight() int {
+	if g.header == nil {
+		return 0
+	}
+	return max(g.cellHeight, 1)
+}
+
+// bodyRect returns the area where cells are drawn: the grid's rectangle minus the header row
+// and the row number gutter, if any.
+func (g *Grid) bodyRect() (x, y, width, height int) {
+	x, y, width, height = g.GetRect()
+	if header := min(g.headerHeight(), max(height, 0)); header > 0 {
+		y += header
+		height -= header
+	}
+	gutter := min(g.gutterWidth(), max(width, 0))
+	return x + gutter, y, width - gutter, height
+}
+
+// drawRowNumbers draws the numbers of the visible rows in the gutter left of the body, which
+// starts at bodyX. Each number is on the first line of its row.
+func (g *Grid) drawRowNumbers(screen tcell.Screen, bodyX, y, height int) {
+	gutter := g.gutterWidth()
+	x := bodyX - gutter
+	Fill(screen, x, y, gutter, height, ' ', g.rowNumberStyle)
+	cellH := max(g.cellHeight, 1)
+	for r := 0; r*cellH < height; r++ {
+		row := g.topRow + r
+		if row >= len(g.cells) {
+			break
+		}
+		number := strconv.Itoa(row + 1)
+		DrawText(screen, x+gutter-1-len(number), y+r*cellH, g.rowNumberStyle, number)
+	}
+}
+
+// drawHeader draws the header titles over the visible columns, aligned with the body cells. The
+// header is height lines tall, and titles are placed like cell content: word-wrapped from the top
+// with cell wrapping on, otherwise on the middle line.
+func (g *Grid) drawHeader(screen tcell.Screen, x, y, width, height int) {
+	Fill(screen, x, y, width, height, ' ', g.headerStyle)
+	wrapped := g.cellWrap && height > 1
+	cellX := x
+	for col := g.leftCol; col < len(g.header); col++ {
+		colWidth := g.columnWidth(col)
+		remainingWidth := x + width - cellX
+		if remainingWidth <= 0 {
+			break
+		}
+		if colWidth > remainingWidth {
+			if col != g.leftCol {
+				break // Only fully visible columns, as in the body
+			}
+			colWidth = remainingWidth
+		}
+		if maxWidth := colWidth - g.padding - g.padding; maxWidth > 0 {
+			lines := []string{runewidth.Truncate(g.header[col], maxWidth, "…")}
+			lineY := y + height/2
+			if wrapped {
+				lines, _ = cellLines(g.header[col], maxWidth, height)
+				lineY = y
+			}
+			for i, line := range lines {
+				DrawText(screen, alignedX(cellX+g.padding, maxWidth, runewidth.StringWidth(line), g.cellAlign), lineY+i, g.headerStyle, line)
+			}
+		}
+		cellX += colWidth
+	}
+}
+
 // SetPadding sets the internal padding (space on left/right) within cells.
 func (g *Grid) SetPadding(padding int) {
 	if padding < 0 {
@@ -258,6 +674,58 @@ func (g *Grid) SetSelectionMode(mode SelectionMode) {
 	}
 }
 
+// SetLoading switches the grid's loading state. While loading, the grid shows a centered
+// placeholder (with an optional spinner) instead of its cells and ignores navigation.
+// SetCells turns loading off automatically when the data arrives.
+func (g *Grid) SetLoading(loading bool) {
+	if g.loading == loading {
+		return
+	}
+	g.loading = loading
+	if !loading && g.spinnerTimer != nil {
+		g.spinnerTimer.Stop() // Stop animating; a tick already queued will see loading == false
+		g.spinnerTimer = nil
+	}
+	g.MarkDirty()
+}
+
+// IsLoading returns whether the grid is currently showing its loading placeholder.
+func (g *Grid) IsLoading() bool {
+	return g.loading
+}
+
+// SetEmptyText sets a message shown centered in a dimmed style while the grid has no cells
+// (e.g., "No results"). The message disappears as soon as cells are set. An empty string
+// (the default) leaves the grid blank. Empty grids are never focusable.
+func (g *Grid) SetEmptyText(text string) {
+	if g.emptyText != text {
+		g.emptyText = text
+		if len(g.cells) == 0 {
+			g.MarkDirty()
+		}
+	}
+}
+
+// SetLoadingText sets the placeholder text shown while loading (default "Loading…").
+func (g *Grid) SetLoadingText(text string) {
+	if g.loadingText != text {
+		g.loadingText = text
+		if g.loading {
+			g.MarkDirty()
+		}
+	}
+}
+
+// SetLoadingSpinner sets whether an animated spinner is shown next to the loading text.
+func (g *Grid) SetLoadingSpinner(show bool) {
+	if g.loadingSpinner != show {
+		g.loadingSpinner = show
+		if g.loading {
+			g.MarkDirty()
+		}
+	}
+}
+
 // SetOnChange sets the callback function triggered when the selected cell changes.
 func (g *Grid) SetOnChange(handler func(row, col int, item string)) {
 	g.onChange = handler
@@ -268,164 +736,811 @@ func (g *Grid) SetOnSelect(handler func(row, col int, item string)) {
 	g.onSelect = handler
 }
 
-// Focusable returns true if the grid is visible and contains selectable cells.
-func (g *Grid) Focusable() bool {
-	// Check if visible and has at least one cell
-	return g.IsVisible() && len(g.cells) > 0 && len(g.cells[0]) > 0 // Assumes rectangular
+// SetOnMultiSelectConfirm sets a callback that receives the complete set of interacted cells when the
+// confirm key (see SetConfirmKey, default Enter) is pressed in MultiSelect mode, so a multi-select-then-act
+// flow needs no separate button. While set, the confirm key no longer toggles cells; Space still does.
+func (g *Grid) SetOnMultiSelectConfirm(handler func(cells [][2]int)) {
+	g.onConfirm = handler
 }
 
-// selectCell moves the selection to the specified row and col.
-// Returns true if the selection actually changed. Handles initial selection.
-func (g *Grid) selectCell(row, col int) bool {
-	numRows := len(g.cells)
-	if numRows == 0 {
-		return false
-	} // Cannot select in empty grid
-	numCols := len(g.cells[0]) // Assumes rectangular grid
-	if numCols == 0 {
-		return false
-	} // Cannot select if no columns
+// SetConfirmKey sets the key combination that confirms a multi-selection (default Enter).
+func (g *Grid) SetConfirmKey(combo KeyModCombo) {
+	g.confirmKey = combo
+}
 
-	initialSelection := false
-	// Handle initial selection if none exists
-	if g.selectedRow < 0 || g.selectedCol < 0 {
-		initialSelection = true
-		// Start selection clamped to valid range
-		if row < 0 {
-			row = 0
-		}
-		if row >= numRows {
-			row = numRows - 1
-		}
-		if col < 0 {
-			col = 0
-		}
-		if col >= numCols {
-			col = numCols - 1
-		}
-	} else {
-		// Clamp target coordinates to valid range for existing selection movement
-		if row < 0 {
-			row = 0
-		}
-		if row >= numRows {
-			row = numRows - 1
-		}
-		if col < 0 {
-			col = 0
-		}
-		if col >= numCols {
-			col = numCols - 1
-		}
-	}
+// SetOnEdit sets the callback function triggered for each cell whose content is changed by cut or paste.
+func (g *Grid) SetOnEdit(handler func(row, col int, oldValue, newValue string)) {
+	g.onEdit = handler
+}
 
-	// Check if selection actually changed from the previous valid state
-	prevRow, prevCol := g.selectedRow, g.selectedCol
-	if !initialSelection && prevRow == row && prevCol == col {
-		return false // No change needed
+// SetClipboardEnabled sets whether the grid handles Ctrl+C (copy), Ctrl+X (cut) and Ctrl+V (paste)
+// while focused. When enabled, Ctrl+C no longer quits the application while the grid has focus
+// and something to copy (Escape still does); see HandlesCopy.
+func (g *Grid) SetClipboardEnabled(enabled bool) {
+	g.clipboard = enabled
+}
+
+// HandlesCopy reports whether the grid claims Ctrl+C for copying: only with the clipboard
+// enabled, while not loading, and with cells to copy. Used by the application to decide whether
+// Ctrl+C quits or is passed to the focused component.
+func (g *Grid) HandlesCopy() bool {
+	if !g.clipboard || g.loading {
+		return false
 	}
+	_, _, _, _, ok := g.clipboardRange()
+	return ok
+}
 
-	g.selectedRow = row
-	g.selectedCol = col
+// SetOnScroll sets the callback function triggered when the visible window moves: when the top row,
+// the number of visible rows (e.g., after a resize) or the left-most column changes. It receives the
+// same range as VisibleRowRange, which lazy-loading grids can use to prefetch data.
+func (g *Grid) SetOnScroll(handler func(top, bottom int)) {
+	g.onScroll = handler
+}
 
-	// Ensure the new selection is visible
-	g.ensureSelectionVisible()
-	g.MarkDirty()
+// TopRow returns the index of the first visible row.
+func (g *Grid) TopRow() int {
+	return g.topRow
+}
 
-	// Trigger change event if selection coords actually changed OR if it was the initial selection
-	if g.onChange != nil {
-		if initialSelection || prevRow != row || prevCol != col {
-			g.onChange(row, col, g.cells[row][col])
-		}
+// SetTopRow scrolls so the given row is the first visible one, clamped so the last page stays full.
+// The selection is left unchanged even if it scrolls out of view; the view follows the selection
+// again once it moves. Use it to restore a scroll position after SetCells or to tail a log.
+func (g *Grid) SetTopRow(row int) {
+	_, _, _, height := g.bodyRect()
+	visibleRows := max(height/max(g.cellHeight, 1), 1)
+	row = min(max(row, 0), max(len(g.cells)-visibleRows, 0))
+	g.scrollDetached = true
+	if g.topRow != row {
+		g.topRow = row
+		g.MarkDirty()
+		g.notifyScroll()
 	}
+}
 
-	return true // Selection was made or changed
+// ScrollToTop scrolls to the first row without changing the selection (see SetTopRow).
+func (g *Grid) ScrollToTop() {
+	g.SetTopRow(0)
 }
 
-// ensureSelectionVisible adjusts the scroll offsets (topRow, leftCol)
-// so that the currently selected cell is within the visible area.
-func (g *Grid) ensureSelectionVisible() {
-	if g.selectedRow < 0 || g.selectedCol < 0 {
-		return
-	} // No selection
+// ScrollToBottom scrolls so the last row is visible without changing the selection (see SetTopRow).
+func (g *Grid) ScrollToBottom() {
+	g.SetTopRow(len(g.cells))
+}
 
-	_, _, width, height := g.GetRect()
-	if width <= 0 || height <= 0 {
-		return
-	} // Component not sized
+// gridReachDebounce is the minimum time between two onReachTop (or onReachBottom) calls while
+// navigation keeps pressing against the same edge.
+const gridReachDebounce = 500 * time.Millisecond
 
-	// Calculate effective cell dimensions for visibility check
-	effectiveCellWidth := g.cellWidth
-	if g.autoWidth {
-		effectiveCellWidth = g.calculateCellWidth()
-		if effectiveCellWidth <= 0 {
-			effectiveCellWidth = 1
-		} // Avoid division by zero if calculation fails
-	}
-	effectiveCellHeight := g.cellHeight
-	if effectiveCellHeight <= 0 {
-		effectiveCellHeight = 1
-	} // Avoid division by zero
+// SetOnReachTop sets the callback function triggered when vertical navigation (Up, PgUp, k)
+// reaches the first row, e.g. to prepend older entries in a chat or log view. Presses against
+// the top edge fire it again, at most once every half second, so holding the key doesn't spam it.
+func (g *Grid) SetOnReachTop(handler func()) {
+	g.onReachTop = handler
+}
 
-	// Calculate number of visible rows/cols based on component size and cell size
-	visibleRows := height / effectiveCellHeight
-	visibleCols := width / effectiveCellWidth
-	if visibleRows <= 0 {
-		visibleRows = 1
-	} // Ensure at least one row is considered visible
-	if visibleCols <= 0 {
-		visibleCols = 1
-	} // Ensure at least one col is considered visible
+// SetOnReachBottom sets the callback function triggered when vertical navigation (Down, PgDn, j)
+// reaches the last row, e.g. to append more data. It is debounced like SetOnReachTop.
+func (g *Grid) SetOnReachBottom(handler func()) {
+	g.onReachBottom = handler
+}
 
-	// Adjust vertical scroll (topRow)
-	if g.selectedRow < g.topRow {
-		g.topRow = g.selectedRow // Scroll up: Make selected row the top row
-	} else if g.selectedRow >= g.topRow+visibleRows {
-		g.topRow = g.selectedRow - visibleRows + 1 // Scroll down: Make selected row the bottom row
+// notifyReachEdge fires onReachTop or onReachBottom after vertical navigation in the given
+// direction (-1 up, +1 down) if the selection is on that edge and the debounce has elapsed.
+func (g *Grid) notifyReachEdge(direction int) {
+	now := time.Now()
+	switch {
+	case direction < 0 && g.selectedRow == 0 && g.onReachTop != nil:
+		if now.Sub(g.reachedTop) >= gridReachDebounce {
+			g.reachedTop = now
+			g.onReachTop()
+		}
+	case direction > 0 && g.selectedRow == len(g.cells)-1 && g.onReachBottom != nil:
+		if now.Sub(g.reachedBottom) >= gridReachDebounce {
+			g.reachedBottom = now
+			g.onReachBottom()
+		}
 	}
+}
 
-	// Adjust horizontal scroll (leftCol)
-	if g.selectedCol < g.leftCol {
-		g.leftCol = g.selectedCol // Scroll left: Make selected col the left col
-	} else if g.selectedCol >= g.leftCol+visibleCols {
-		g.leftCol = g.selectedCol - visibleCols + 1 // Scroll right: Make selected col the right col
+// VisibleRowRange returns the indices of the first and last (inclusive) rows currently visible.
+// Returns (0, -1) when no rows are visible (empty or unsized grid).
+func (g *Grid) VisibleRowRange() (top, bottom int) {
+	_, _, _, height := g.bodyRect()
+	cellH := g.cellHeight
+	if cellH <= 0 {
+		cellH = 1
 	}
+	visibleRows := height / cellH
+	if visibleRows <= 0 || len(g.cells) == 0 {
+		return 0, -1
+	}
+	return g.topRow, min(g.topRow+visibleRows, len(g.cells)) - 1
+}
 
-	// --- Clamp scroll values to valid ranges ---
-	numRows := len(g.cells)
-	numCols := 0
-	if numRows > 0 {
-		numCols = len(g.cells[0])
-	} // Assumes rectangular
-
-	// Clamp topRow
-	if g.topRow < 0 {
-		g.topRow = 0
+// notifyScroll fires onScroll if the visible window changed since it was last reported.
+func (g *Grid) notifyScroll() {
+	top, bottom := g.VisibleRowRange()
+	current := [3]int{top, bottom, g.leftCol}
+	if current == g.scrollReported {
+		return
 	}
-	maxTopRow := numRows - visibleRows // Max topRow is numRows minus the number that fit
-	if maxTopRow < 0 {
-		maxTopRow = 0
-	} // Handle case where visibleRows > numRows
-	if g.topRow > maxTopRow {
-		g.topRow = maxTopRow
+	if prev := g.scrollReported; prev[0] >= 0 && prev[0] != current[0] {
+		g.revealScrollBar() // Vertical scroll: show an auto-hiding scrollbar
+	}
+	g.scrollReported = current
+	if g.onScroll != nil {
+		g.onScroll(top, bottom)
 	}
+}
 
-	// Clamp leftCol
-	if g.leftCol < 0 {
-		g.leftCol = 0
+// SetRect sets the grid's position and size, keeping the selection visible in the new area.
+func (g *Grid) SetRect(x, y, width, height int) {
+	g.BaseComponent.SetRect(x, y, width, height)
+	g.ensureSelectionVisible()
+}
+
+// SetSelectOnFocus sets how the selection changes when the grid gains focus: keep the previous
+// selection (default), move to the first cell, or clear it until the first navigation key.
+// Selection callbacks (onCellLeave/onCellEnter/onChange) fire for the resulting change.
+func (g *Grid) SetSelectOnFocus(mode SelectOnFocus) {
+	g.selectOnFocus = mode
+}
+
+// Focus gives the grid input focus and applies the SelectOnFocus behavior.
+func (g *Grid) Focus() {
+	if g.IsFocused() {
+		return
 	}
-	maxLeftCol := numCols - visibleCols // Max leftCol is numCols minus the number that fit
-	if maxLeftCol < 0 {
-		maxLeftCol = 0
-	} // Handle case where visibleCols > numCols
-	if g.leftCol > maxLeftCol {
-		g.leftCol = maxLeftCol
+	g.BaseComponent.Focus()
+
+	switch g.selectOnFocus {
+	case SelectFirst:
+		g.selectCell(0, 0)
+	case SelectNone:
+		prevRow, prevCol := g.selectedRow, g.selectedCol
+		if prevRow >= 0 && prevCol >= 0 {
+			g.selectedRow, g.selectedCol = -1, -1
+			g.notifyCellMove(prevRow, prevCol, -1, -1)
+			g.MarkDirty()
+		}
 	}
-	// No need to MarkDirty here, as this is called before drawing or after selection change which already marks dirty.
 }
 
-// toggleCellInteraction toggles the interaction state of the currently selected cell
-// based on the SelectionMode and triggers the onSelect callback.
+// Blur removes input focus from the grid, closing the "go to row" prompt if open.
+func (g *Grid) Blur() {
+	g.closeGoto()
+	g.BaseComponent.Blur()
+}
+
+// SetGotoEnabled enables the "go to row" prompt: pressing the goto key (':' by default) opens a
+// small numeric input on the grid's bottom line; entering a 1-based row number and pressing Enter
+// moves the selection to that row. Escape closes the prompt without moving.
+func (g *Grid) SetGotoEnabled(enabled bool) {
+	g.gotoEnabled = enabled
+	if !enabled {
+		g.closeGoto()
+	}
+}
+
+// SetGotoKey sets the rune that opens the "go to row" prompt (default ':').
+func (g *Grid) SetGotoKey(key rune) {
+	g.gotoKey = key
+}
+
+// openGoto shows the "go to row" prompt, accepting as many digits as the row count has.
+func (g *Grid) openGoto() {
+	input := NewTextInput()
+	input.SetApplication(g.app)
+	input.SetMaxLength(len(strconv.Itoa(len(g.cells))))
+	input.SetOnSubmit(func(text string) {
+		g.closeGoto()
+		if row, err := strconv.Atoi(text); err == nil && row > 0 {
+			g.selectCell(row-1, max(g.selectedCol, 0)) // Clamped to the last row
+		}
+	})
+	input.Focus()
+	g.gotoInput = input
+	g.MarkDirty()
+}
+
+// closeGoto hides the "go to row" prompt, if open.
+func (g *Grid) closeGoto() {
+	if g.gotoInput != nil {
+		g.gotoInput = nil
+		g.MarkDirty()
+	}
+}
+
+// handleGotoKey passes keys to the open "go to row" prompt. Only digits and the keys that edit
+// or submit the input are consumed; any other key (e.g., Tab or Ctrl+PgDn) is left unhandled
+// so the application can act on it. Moving focus away closes the prompt (see Blur).
+func (g *Grid) handleGotoKey(ev *tcell.EventKey) bool {
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		g.closeGoto()
+	case tcell.KeyRune:
+		if ev.Rune() < '0' || ev.Rune() > '9' || ev.Modifiers()&(tcell.ModCtrl|tcell.ModAlt|tcell.ModMeta) != 0 {
+			return false
+		}
+		g.gotoInput.HandleEvent(ev)
+	case tcell.KeyEnter, tcell.KeyBackspace, tcell.KeyBackspace2, tcell.KeyDelete,
+		tcell.KeyLeft, tcell.KeyRight, tcell.KeyHome, tcell.KeyEnd:
+		g.gotoInput.HandleEvent(ev) // Enter submits; the others edit
+	default:
+		return false
+	}
+	g.MarkDirty()
+	return true
+}
+
+// drawGoto draws the "go to row" prompt over the bottom line of the grid area.
+func (g *Grid) drawGoto(screen tcell.Screen, x, y, width, height int) {
+	if width < 2 || height <= 0 {
+		return
+	}
+	promptY := y + height - 1
+	screen.SetContent(x, promptY, g.gotoKey, nil, g.focusedStyle.ToTcell())
+	inputWidth := min(width-1, len(strconv.Itoa(len(g.cells)))+1) // Room for the cursor after the digits
+	g.gotoInput.SetRect(x+1, promptY, inputWidth, 1)
+	g.gotoInput.Draw(screen)
+}
+
+// SetActivateKeys replaces the non-rune keys that activate the selected cell (toggle it and fire
+// onSelect/onToggle). The default is Enter. Keys set here take precedence over navigation, so
+// e.g. passing tcell.KeyRight makes Right activate instead of moving.
+func (g *Grid) SetActivateKeys(keys ...tcell.Key) {
+	g.activateKeys = keys
+}
+
+// SetActivateRunes replaces the runes that activate the selected cell. The default is Space.
+// Runes set here take precedence over the h/j/k/l navigation runes.
+func (g *Grid) SetActivateRunes(runes ...rune) {
+	g.activateRunes = runes
+}
+
+// SetButtonMode makes activation (Enter/Space by default) a pure press: onSelect fires but the
+// cell's interacted state is left unchanged, so a button grid doesn't stay highlighted after use.
+// onToggle does not fire in button mode. SetCellInteracted still works for explicit feedback.
+func (g *Grid) SetButtonMode(button bool) {
+	g.buttonMode = button
+}
+
+// SetAdvanceOnSelect makes activation move the selection on after onSelect (and onToggle) fire:
+// down one row, or right one column in a single-row grid, for rapid check-off workflows.
+// The selection stays put on the last row (or column); onChange fires for every move.
+func (g *Grid) SetAdvanceOnSelect(advance bool) {
+	g.advanceOnSelect = advance
+}
+
+// Activate activates the selected cell as if an activation key were pressed: the interacted state
+// toggles (unless in button mode), onSelect and onToggle fire, and the selection advances if
+// SetAdvanceOnSelect is on. Does nothing while loading, hidden, or without a selection.
+// Call from the main loop (e.g., a key handler or a command); the grid is redrawn as needed.
+func (g *Grid) Activate() {
+	if g.loading || !g.IsVisible() || g.selectedRow < 0 || g.selectedCol < 0 {
+		return
+	}
+	g.toggleCellInteraction()
+	if g.advanceOnSelect {
+		g.advanceSelection()
+	}
+}
+
+// advanceSelection moves the selection one row down, or one column right in a single-row grid.
+func (g *Grid) advanceSelection() {
+	if g.selectedRow < 0 || g.selectedCol < 0 {
+		return
+	}
+	if len(g.cells) > 1 {
+		g.selectCell(g.selectedRow+1, g.selectedCol)
+	} else {
+		g.selectCell(g.selectedRow, g.selectedCol+1)
+	}
+}
+
+// SetOnToggle sets the callback function triggered when a cell is activated (Enter/Space), after its
+// interaction state has been toggled. It receives the new state, so handlers don't need to query
+// IsCellInteracted. Fires after the SetOnSelect callback, which remains available.
+func (g *Grid) SetOnToggle(handler func(row, col int, item string, interacted bool)) {
+	g.onToggle = handler
+}
+
+// SetOnBulkToggle sets the callback function triggered once after ToggleRow or ToggleColumn, with
+// the cells whose interacted state changed and their new state.
+func (g *Grid) SetOnBulkToggle(handler func(cells [][2]int, interacted bool)) {
+	g.onBulkToggle = handler
+}
+
+// ToggleRow toggles the interacted state of every cell in the row as a group: if all of them are
+// interacted they are all cleared, otherwise they are all set. Only applies in MultiSelect mode.
+// Bound to Shift+Space on the selected row (in terminals that report Shift with Space).
+func (g *Grid) ToggleRow(row int) {
+	if row < 0 || row >= len(g.cells) {
+		return
+	}
+	cells := make([][2]int, 0, len(g.cells[row]))
+	for col := range g.cells[row] {
+		cells = append(cells, [2]int{row, col})
+	}
+	g.toggleCells(cells)
+}
+
+// ToggleColumn toggles the interacted state of every cell in the column as a group, like ToggleRow.
+// Only applies in MultiSelect mode. Bound to Ctrl+Space on the selected column.
+func (g *Grid) ToggleColumn(col int) {
+	cells := make([][2]int, 0, len(g.cells))
+	for row := range g.cells {
+		if col >= 0 && col < len(g.cells[row]) {
+			cells = append(cells, [2]int{row, col})
+		}
+	}
+	g.toggleCells(cells)
+}
+
+// toggleCells sets all of the cells interacted, or clears them all if they already are, and
+// fires onBulkToggle once with the cells that changed.
+func (g *Grid) toggleCells(cells [][2]int) {
+	if g.selectionMode != MultiSelect || len(cells) == 0 {
+		return
+	}
+	interacted := false
+	for _, cell := range cells {
+		if !g.interactedCells[fmt.Sprintf("%d:%d", cell[0], cell[1])] {
+			interacted = true // Something is off: turn the whole group on
+			break
+		}
+	}
+	changed := make([][2]int, 0, len(cells))
+	for _, cell := range cells {
+		cellKey := fmt.Sprintf("%d:%d", cell[0], cell[1])
+		if g.interactedCells[cellKey] == interacted {
+			continue
+		}
+		if interacted {
+			g.interactedCells[cellKey] = true
+		} else {
+			delete(g.interactedCells, cellKey)
+		}
+		changed = append(changed, cell)
+	}
+	g.MarkDirty()
+	if g.onBulkToggle != nil && len(changed) > 0 {
+		g.onBulkToggle(changed, interacted)
+	}
+}
+
+// SetOnCellEnter sets the callback function triggered when the cursor enters a cell.
+// Fires after the leave callback for the previous cell, and before onChange.
+func (g *Grid) SetOnCellEnter(handler func(row, col int)) {
+	g.onCellEnter = handler
+}
+
+// SetOnCellFocus sets the callback function triggered with the full, untruncated content of each
+// newly selected cell (e.g., to show it in a status line). Fires right after onCellEnter.
+func (g *Grid) SetOnCellFocus(handler func(row, col int, fullContent string)) {
+	g.onCellFocus = handler
+}
+
+// SetShowFullCellTooltip enables a tooltip showing the full content of the selected cell while the
+// grid has focus and the content is truncated. It is drawn on the line below the cell (above it on
+// the last visible line), within the grid's area.
+func (g *Grid) SetShowFullCellTooltip(show bool) {
+	if g.cellTooltip != show {
+		g.cellTooltip = show
+		g.MarkDirty()
+	}
+}
+
+// drawCellTooltip draws content on the line next to contentY, starting near cellX and shifted
+// left as needed to stay within the grid's area.
+func (g *Grid) drawCellTooltip(screen tcell.Screen, x, y, width, height, cellX, contentY int, content string) {
+	tipY := contentY + 1
+	if tipY >= y+height {
+		tipY = contentY - 1 // No room below: show it above
+	}
+	if tipY < y {
+		return // Single-line grid: nowhere to show it
+	}
+	text := runewidth.Truncate(" "+content+" ", width, "…")
+	tipX := max(min(cellX, x+width-runewidth.StringWidth(text)), x)
+	DrawText(screen, tipX, tipY, g.focusedSelectedStyle, text)
+}
+
+// SetOnCellLeave sets the callback function triggered when the cursor leaves a cell.
+// Fires before the enter callback for the new cell.
+func (g *Grid) SetOnCellLeave(handler func(row, col int)) {
+	g.onCellLeave = handler
+}
+
+// notifyCellMove fires the leave callback for the previous cell (if there was one)
+// followed by the enter callback for the new cell (if there is one).
+func (g *Grid) notifyCellMove(prevRow, prevCol, row, col int) {
+	if g.onCellLeave != nil && prevRow >= 0 && prevCol >= 0 {
+		g.onCellLeave(prevRow, prevCol)
+	}
+	if g.onCellEnter != nil && row >= 0 && col >= 0 {
+		g.onCellEnter(row, col)
+	}
+	if g.onCellFocus != nil && row >= 0 && row < len(g.cells) && col >= 0 && col < len(g.cells[row]) {
+		g.onCellFocus(row, col, g.cells[row][col])
+	}
+}
+
+// KeyHints returns the keys the grid responds to while focused.
+func (g *Grid) KeyHints() []KeyHint {
+	if g.loading {
+		return nil // Navigation is suspended while loading
+	}
+	if g.gotoInput != nil {
+		return []KeyHint{
+			{Key: "0-9", Description: "row number"},
+			{Key: "Enter", Description: "go"},
+			{Key: "Esc", Description: "cancel"},
+		}
+	}
+	action := "select"
+	if g.buttonMode {
+		action = "press"
+	} else if g.selectionMode == MultiSelect {
+		action = "toggle"
+	}
+	hints := []KeyHint{
+		{Key: "Arrows/hjkl", Description: "move"},
+		{Key: "PgUp/PgDn", Description: "page"},
+		{Key: "Home/End", Description: "first/last column"},
+		{Key: g.activateKeysName(), Description: action},
+	}
+	if g.confirming() {
+		hints = append(hints, KeyHint{Key: g.confirmKey.String(), Description: "confirm"})
+	}
+	if g.clipboard {
+		hints = append(hints, KeyHint{Key: "Ctrl+C/X/V", Description: "copy/cut/paste"})
+	}
+	if g.selectionMode == MultiSelect && !g.buttonMode {
+		hints = append(hints, KeyHint{Key: "Shift+Space/Ctrl+Space", Description: "toggle row/column"})
+	}
+	if g.gotoEnabled {
+		hints = append(hints, KeyHint{Key: string(g.gotoKey), Description: "go to row"})
+	}
+	return hints
+}
+
+// activateKeysName returns a label such as "Enter/Space" for the activation keys, leaving out the
+// confirm key when it takes precedence.
+func (g *Grid) activateKeysName() string {
+	names := make([]string, 0, len(g.activateKeys)+len(g.activateRunes))
+	for _, key := range g.activateKeys {
+		if g.confirming() && key == g.confirmKey.Key {
+			continue // Confirms instead of toggling
+		}
+		names = append(names, KeyModCombo{Key: key}.String())
+	}
+	for _, r := range g.activateRunes {
+		if r == ' ' {
+			names = append(names, "Space")
+		} else {
+			names = append(names, string(r))
+		}
+	}
+	return strings.Join(names, "/")
+}
+
+// isActivateKey reports whether the key event is one of the grid's activation keys or runes.
+func (g *Grid) isActivateKey(ev *tcell.EventKey) bool {
+	if ev.Key() == tcell.KeyRune {
+		return slices.Contains(g.activateRunes, ev.Rune())
+	}
+	return slices.Contains(g.activateKeys, ev.Key())
+}
+
+// pageRows returns the number of rows a page key moves: the rows that fit in the body (at least 1).
+func (g *Grid) pageRows() int {
+	_, _, _, height := g.bodyRect()
+	cellH := max(g.cellHeight, 1)
+	return max(height/cellH, 1)
+}
+
+// confirming reports whether the confirm key currently fires onConfirm instead of toggling.
+func (g *Grid) confirming() bool {
+	return g.selectionMode == MultiSelect && g.onConfirm != nil
+}
+
+// MinSize returns the space needed to show a single cell: the widest column and one cell height.
+// Implements Constrained.
+func (g *Grid) MinSize() (width, height int) {
+	width = g.baseCellWidth()
+	for col := 0; col < g.numCols(); col++ {
+		width = max(width, g.columnWidth(col))
+	}
+	width += g.gutterWidth()
+	height = max(g.cellHeight, 1) + g.headerHeight()
+	return width, height
+}
+
+// PreferredSize returns the space needed to show every row and column without scrolling.
+// Implements Measurable.
+func (g *Grid) PreferredSize() (width, height int) {
+	width = g.columnSpan(0, max(g.numCols(), len(g.header))-1) + g.gutterWidth()
+	height = len(g.cells)*max(g.cellHeight, 1) + g.headerHeight()
+	return width, height
+}
+
+// Focusable returns true if the grid is visible and contains selectable cells.
+func (g *Grid) Focusable() bool {
+	// Check if visible and has at least one cell
+	return g.IsVisible() && len(g.cells) > 0 && len(g.cells[0]) > 0 // Assumes rectangular
+}
+
+// selectCell moves the selection to the specified row and col.
+// Returns true if the selection actually changed. Handles initial selection.
+func (g *Grid) selectCell(row, col int) bool {
+	numRows := len(g.cells)
+	if numRows == 0 {
+		return false
+	} // Cannot select in empty grid
+	numCols := len(g.cells[0]) // Assumes rectangular grid
+	if numCols == 0 {
+		return false
+	} // Cannot select if no columns
+
+	initialSelection := false
+	// Handle initial selection if none exists
+	if g.selectedRow < 0 || g.selectedCol < 0 {
+		initialSelection = true
+		// Start selection clamped to valid range
+		if row < 0 {
+			row = 0
+		}
+		if row >= numRows {
+			row = numRows - 1
+		}
+		if col < 0 {
+			col = 0
+		}
+		if col >= numCols {
+			col = numCols - 1
+		}
+	} else {
+		// Clamp target coordinates to valid range for existing selection movement
+		if row < 0 {
+			row = 0
+		}
+		if row >= numRows {
+			row = numRows - 1
+		}
+		if col < 0 {
+			col = 0
+		}
+		if col >= numCols {
+			col = numCols - 1
+		}
+	}
+
+	// Check if selection actually changed from the previous valid state
+	prevRow, prevCol := g.selectedRow, g.selectedCol
+	if !initialSelection && prevRow == row && prevCol == col {
+		return false // No change needed
+	}
+
+	g.selectedRow = row
+	g.selectedCol = col
+
+	// Ensure the new selection is visible
+	g.scrollDetached = false // Moving the selection brings the view back to it
+	g.ensureSelectionVisible()
+	g.MarkDirty()
+
+	g.notifyCellMove(prevRow, prevCol, row, col)
+
+	// Trigger change event if selection coords actually changed OR if it was the initial selection
+	if g.onChange != nil {
+		if initialSelection || prevRow != row || prevCol != col {
+			g.onChange(row, col, g.cells[row][col])
+		}
+	}
+
+	return true // Selection was made or changed
+}
+
+// ensureSelectionVisible adjusts the scroll offsets (topRow, leftCol)
+// so that the currently selected cell is within the visible area.
+func (g *Grid) ensureSelectionVisible() {
+	defer g.notifyScroll() // Report any change of the visible window (including resizes)
+
+	if g.selectedRow < 0 || g.selectedCol < 0 {
+		return
+	} // No selection
+
+	_, _, width, height := g.bodyRect()
+	if width <= 0 || height <= 0 {
+		return
+	} // Component not sized
+
+	// Calculate effective cell height for visibility check (widths are per-column)
+	effectiveCellHeight := g.cellHeight
+	if effectiveCellHeight <= 0 {
+		effectiveCellHeight = 1
+	} // Avoid division by zero
+
+	// Calculate number of visible rows based on component size and cell size
+	visibleRows := height / effectiveCellHeight
+	if visibleRows <= 0 {
+		visibleRows = 1
+	} // Ensure at least one row is considered visible
+
+	// Adjust vertical scroll (topRow), unless SetTopRow detached the view from the selection
+	if !g.scrollDetached {
+		if g.selectedRow < g.topRow {
+			g.topRow = g.selectedRow // Scroll up: Make selected row the top row
+		} else if g.selectedRow >= g.topRow+visibleRows {
+			g.topRow = g.selectedRow - visibleRows + 1 // Scroll down: Make selected row the bottom row
+		}
+	}
+
+	// Adjust horizontal scroll (leftCol) using cumulative column widths
+	if g.selectedCol < g.leftCol {
+		g.leftCol = g.selectedCol // Scroll left: Make selected col the left col
+	} else {
+		// Scroll right until the selected column fits entirely (or is the left-most column)
+		for g.leftCol < g.selectedCol && g.columnSpan(g.leftCol, g.selectedCol) > width {
+			g.leftCol++
+		}
+	}
+
+	// --- Clamp scroll values to valid ranges ---
+	numRows := len(g.cells)
+
+	// Clamp topRow
+	if g.topRow < 0 {
+		g.topRow = 0
+	}
+	maxTopRow := numRows - visibleRows // Max topRow is numRows minus the number that fit
+	if maxTopRow < 0 {
+		maxTopRow = 0
+	} // Handle case where visibleRows > numRows
+	if g.topRow > maxTopRow {
+		g.topRow = maxTopRow
+	}
+
+	// Clamp leftCol
+	if g.leftCol < 0 {
+		g.leftCol = 0
+	}
+	maxLeftCol := g.maxLeftCol(width) // Left-most column that still fills the width to the end
+	if g.leftCol > maxLeftCol {
+		g.leftCol = maxLeftCol
+	}
+	// No need to MarkDirty here, as this is called before drawing or after selection change which already marks dirty.
+}
+
+// numCols returns the number of columns in the grid (assumes rectangular data).
+func (g *Grid) numCols() int {
+	if len(g.cells) == 0 {
+		return 0
+	}
+	return len(g.cells[0])
+}
+
+// baseCellWidth returns the uniform cell width used by columns without an override,
+// considering autoWidth.
+func (g *Grid) baseCellWidth() int {
+	width := g.cellWidth
+	if g.autoWidth {
+		width = g.calculateCellWidth()
+	}
+	if width <= 0 {
+		width = 1
+	} // Safety
+	return width
+}
+
+// columnWidth returns the width of a specific column: its override if set,
+// otherwise the uniform base cell width.
+func (g *Grid) columnWidth(col int) int {
+	if width, ok := g.columnWidths[col]; ok && width > 0 {
+		return width
+	}
+	if g.fitWidth {
+		if width, ok := g.fitColumnWidth(col); ok {
+			return width
+		}
+	}
+	return g.baseCellWidth()
+}
+
+// fitColumnWidth returns the width of a non-overridden column when fit width is enabled:
+// the body width left after overridden columns, split evenly with the remainder going to
+// the left-most columns. Returns false if the columns would be too narrow to show content.
+func (g *Grid) fitColumnWidth(col int) (int, bool) {
+	numCols := g.numCols()
+	_, _, available, _ := g.bodyRect() // Excludes the row number gutter
+	if _, _, _, overflows := g.scrollBarMetrics(); g.scrollBar && overflows {
+		available-- // Keep the scrollbar column clear, even while an auto-hiding bar is hidden
+	}
+	flexCols := numCols
+	flexBefore := 0 // Non-overridden columns left of col, to place the remainder
+	for c, width := range g.columnWidths {
+		if c < 0 || c >= numCols || width <= 0 {
+			continue
+		}
+		available -= width
+		flexCols--
+		if c < col {
+			flexBefore++
+		}
+	}
+	if flexCols <= 0 || available <= 0 {
+		return 0, false
+	}
+
+	width := available / flexCols
+	minWidth := g.padding + g.padding + 1 // Room for at least one character
+	if width < minWidth {
+		return 0, false // Degenerate: fall back to the normal width and scroll
+	}
+	if col-flexBefore < available%flexCols {
+		width++ // Distribute the remainder left to right
+	}
+	return width, true
+}
+
+// columnSpan returns the total width of columns in the inclusive range [from, to].
+func (g *Grid) columnSpan(from, to int) int {
+	total := 0
+	for col := from; col <= to; col++ {
+		total += g.columnWidth(col)
+	}
+	return total
+}
+
+// maxLeftCol returns the largest valid leftCol for the given available width:
+// the first column of the longest run of trailing columns that fits entirely.
+func (g *Grid) maxLeftCol(width int) int {
+	numCols := g.numCols()
+	if numCols == 0 {
+		return 0
+	}
+	col := numCols
+	total := 0
+	for col > 0 {
+		colWidth := g.columnWidth(col - 1)
+		if total+colWidth > width {
+			break
+		}
+		total += colWidth
+		col--
+	}
+	if col > numCols-1 {
+		col = numCols - 1 // Even the last column alone doesn't fit; it becomes the left-most
+	}
+	return col
+}
+
+// columnAtX maps a screen X coordinate to the visible column drawn there.
+// Returns the column index, the screen X where that column starts, and false if
+// the coordinate is outside the drawn columns.
+func (g *Grid) columnAtX(screenX int) (col, startX int, ok bool) {
+	x, _, width, _ := g.bodyRect()
+	if screenX < x || screenX >= x+width {
+		return -1, 0, false
+	}
+	cellX := x
+	for c := g.leftCol; c < g.numCols(); c++ {
+		colWidth := g.columnWidth(c)
+		if screenX < cellX+colWidth {
+			return c, cellX, true
+		}
+		cellX += colWidth
+		if cellX >= x+width {
+			break
+		}
+	}
+	return -1, 0, false
+}
+
+// toggleCellInteraction toggles the interaction state of the currently selected cell
+// based on the SelectionMode and triggers the onSelect callback.
 func (g *Grid) toggleCellInteraction() {
 	// Ensure a valid cell is selected
 	row, col := g.selectedRow, g.selectedCol
@@ -433,6 +1548,13 @@ func (g *Grid) toggleCellInteraction() {
 		return // Cannot interact with invalid selection
 	}
 
+	if g.buttonMode {
+		if g.onSelect != nil {
+			g.onSelect(row, col, g.cells[row][col]) // Press without persisting state
+		}
+		return
+	}
+
 	cellKey := fmt.Sprintf("%d:%d", row, col)
 	currentlyInteracted := g.interactedCells[cellKey]
 	stateChanged := false
@@ -469,6 +1591,9 @@ func (g *Grid) toggleCellInteraction() {
 	if g.onSelect != nil {
 		g.onSelect(row, col, g.cells[row][col])
 	}
+	if g.onToggle != nil {
+		g.onToggle(row, col, g.cells[row][col], g.interactedCells[cellKey])
+	}
 }
 
 // Draw renders the grid component onto the screen.
@@ -482,35 +1607,41 @@ func (g *Grid) Draw(screen tcell.Screen) {
 		return
 	}
 
+	if g.loading {
+		g.drawLoading(screen, x, y, width, height)
+		return
+	}
+	if len(g.cells) == 0 && g.emptyText != "" {
+		g.drawEmpty(screen, x, y, width, height)
+		return
+	}
+
 	// Ensure scroll/selection is valid before drawing
 	g.ensureSelectionVisible()
 
-	// Calculate effective cell width (considering autoWidth)
-	effectiveCellWidth := g.cellWidth
-	if g.autoWidth {
-		effectiveCellWidth = g.calculateCellWidth()
-		if effectiveCellWidth <= 0 {
-			effectiveCellWidth = 1
-		} // Safety
+	// Header row at the top and row numbers on the left; the body (cells, scrollbar, prompts) is
+	// drawn in the remaining area
+	bodyX, bodyY, bodyWidth, bodyHeight := g.bodyRect()
+	if headerHeight := bodyY - y; headerHeight > 0 {
+		Fill(screen, x, y, bodyX-x, headerHeight, ' ', g.headerStyle) // Header row above the gutter
+		g.drawHeader(screen, bodyX, y, bodyWidth, headerHeight)
+	}
+	if g.rowNumbers && bodyWidth > 0 && bodyHeight > 0 {
+		g.drawRowNumbers(screen, bodyX, bodyY, bodyHeight)
+	}
+	x, y, width, height = bodyX, bodyY, bodyWidth, bodyHeight
+	if width <= 0 || height <= 0 {
+		return
 	}
+
+	// Cell widths are resolved per column (overrides or uniform/auto width)
 	effectiveCellHeight := g.cellHeight
 	if effectiveCellHeight <= 0 {
 		effectiveCellHeight = 1
 	} // Safety
 
-	// Calculate how many cells fit
-	visibleRows := 0
-	if effectiveCellHeight > 0 {
-		visibleRows = height / effectiveCellHeight
-	} else {
-		return
-	} // Can't draw if cell height is invalid
-	visibleCols := 0
-	if effectiveCellWidth > 0 {
-		visibleCols = width / effectiveCellWidth
-	} else {
-		return
-	} // Can't draw if cell width is invalid
+	// Calculate how many rows fit
+	visibleRows := height / effectiveCellHeight
 
 	// Get necessary state for drawing
 	isFocused := g.IsFocused()
@@ -527,6 +1658,9 @@ func (g *Grid) Draw(screen tcell.Screen) {
 	// Fill background of the entire grid area using the grid's base style
 	Fill(screen, x, y, width, height, ' ', g.style)
 
+	// Selected cell whose content was truncated (for the tooltip)
+	tooltipX, tooltipY, tooltipText := 0, 0, ""
+
 	// Draw visible cells
 	for r := 0; r < visibleRows; r++ {
 		gridRow := currentTopRow + r
@@ -534,16 +1668,21 @@ func (g *Grid) Draw(screen tcell.Screen) {
 			break
 		} // Stop if we run out of rows
 
-		for c := 0; c < visibleCols; c++ {
-			gridCol := currentLeftCol + c
-			// Assumes rectangular grid, check column bounds based on the first row? Safest is to check each row.
-			if gridCol >= len(g.cells[gridRow]) {
+		cellX := x // Screen X of the current column, advanced by each column's width
+		cellY := y + r*effectiveCellHeight
+		for gridCol := currentLeftCol; gridCol < len(g.cells[gridRow]); gridCol++ {
+			// Resolve this column's width and stop once we run out of horizontal space
+			colWidth := g.columnWidth(gridCol)
+			remainingWidth := x + width - cellX
+			if remainingWidth <= 0 {
 				break
-			} // Stop if we run out of columns for *this* row
-
-			// Calculate screen coordinates for this cell
-			cellX := x + c*effectiveCellWidth
-			cellY := y + r*effectiveCellHeight
+			}
+			if colWidth > remainingWidth {
+				if gridCol != currentLeftCol {
+					break // Only draw fully visible columns...
+				}
+				colWidth = remainingWidth // ...unless the left-most column alone is too wide; clip it
+			}
 
 			// Determine cell state
 			isSelected := (gridRow == selectedRow && gridCol == selectedCol)
@@ -564,18 +1703,38 @@ func (g *Grid) Draw(screen tcell.Screen) {
 				isFocused, // Pass focus state
 			)
 
+			// Marked and highlighted cells only restyle the normal state; selection and interaction stay on top
+			stateStyled := isSelected || isInteracted
+			if !stateStyled {
+				if gridRow == g.markedRow && gridCol == g.markedCol {
+					cellStyle, stateStyled = g.markedStyle, true
+				} else if g.highlighted[cellKey] {
+					cellStyle, stateStyled = g.highlightStyle, true
+				}
+			}
+
+			// Per-cell overrides; cells in a highlighted state keep their background so the state stays visible
+			if override, ok := g.cellStyles[cellKey]; ok {
+				if stateStyled {
+					fg, _, attrs, _ := override.Deconstruct()
+					override = DefaultStyle.Foreground(fg).Attributes(attrs)
+				}
+				cellStyle = cellStyle.MergeWith(override)
+			}
+
 			// Draw cell background using the determined style
-			Fill(screen, cellX, cellY, effectiveCellWidth, effectiveCellHeight, ' ', cellStyle)
+			Fill(screen, cellX, cellY, colWidth, effectiveCellHeight, ' ', cellStyle)
 
 			// Draw selection indicator (if applicable)
+			wrapped := g.cellWrap && effectiveCellHeight > 1
 			indicatorWidth := 0
 			if g.showIndicator && isSelected && isFocused {
 				// Draw indicator at the beginning of the cell
 				indicatorX := cellX
 				// Position indicator vertically in the middle if cellHeight > 1? For now, top.
 				indicatorY := cellY + (effectiveCellHeight / 2)
-				if effectiveCellHeight == 1 {
-					indicatorY = cellY
+				if effectiveCellHeight == 1 || wrapped {
+					indicatorY = cellY // Wrapped content starts on the top line
 				}
 
 				// Use the dedicated indicator style
@@ -587,21 +1746,98 @@ func (g *Grid) Draw(screen tcell.Screen) {
 			// Content starts after indicator (if shown) and left padding
 			contentStartX := cellX + indicatorWidth + g.padding
 			// Available width is cell width minus left padding, right padding, and indicator width
-			contentMaxWidth := effectiveCellWidth - g.padding - g.padding - indicatorWidth
+			contentMaxWidth := colWidth - g.padding - g.padding - indicatorWidth
 			// Position content vertically in the middle if cellHeight > 1? For now, top.
 			contentY := cellY + (effectiveCellHeight / 2)
 			if effectiveCellHeight == 1 {
 				contentY = cellY
 			}
 
-			if contentMaxWidth > 0 && contentY < y+height { // Check content fits and Y is valid
+			if wrapped && contentMaxWidth > 0 {
+				// Multi-line content from the top of the cell, clipped to the grid's height
+				content := g.cells[gridRow][gridCol]
+				lines, truncated := cellLines(content, contentMaxWidth, effectiveCellHeight)
+				for i, line := range lines {
+					if cellY+i >= y+height {
+						truncated = true
+						break
+					}
+					DrawText(screen, alignedX(contentStartX, contentMaxWidth, runewidth.StringWidth(line), g.cellAlign), cellY+i, cellStyle, line)
+				}
+				if isSelected && truncated {
+					tooltipX, tooltipY, tooltipText = cellX, min(cellY+effectiveCellHeight, y+height)-1, strings.ReplaceAll(content, "\n", " ") // Tooltip below the cell
+				}
+			} else if contentMaxWidth > 0 && contentY < y+height { // Check content fits and Y is valid
 				content := g.cells[gridRow][gridCol]
 				// Truncate content if it's wider than available space
 				displayText := runewidth.Truncate(content, contentMaxWidth, "…") // Use ellipsis for truncation
-				DrawText(screen, contentStartX, contentY, cellStyle, displayText)
+				textX := alignedX(contentStartX, contentMaxWidth, runewidth.StringWidth(displayText), g.cellAlign)
+				DrawText(screen, textX, contentY, cellStyle, displayText)
+				if isSelected && displayText != content {
+					tooltipX, tooltipY, tooltipText = cellX, contentY, content
+				}
 			}
+
+			cellX += colWidth // Advance to the next column's start
 		}
 	}
+
+	g.drawScrollBar(screen, x, y, width, height)
+	if g.cellTooltip && isFocused && tooltipText != "" {
+		g.drawCellTooltip(screen, x, y, width, height, tooltipX, tooltipY, tooltipText)
+	}
+	if g.gotoInput != nil {
+		g.drawGoto(screen, x, y, width, height)
+	}
+}
+
+// spinnerFrames are the animation frames of the loading spinner.
+var spinnerFrames = []rune{'|', '/', '-', '\\'}
+
+// spinnerInterval is the delay between loading spinner frames.
+const spinnerInterval = 100 * time.Millisecond
+
+// drawLoading renders the loading placeholder centered in the grid area
+// and keeps the spinner animation running while the grid is loading.
+func (g *Grid) drawLoading(screen tcell.Screen, x, y, width, height int) {
+	Fill(screen, x, y, width, height, ' ', g.style)
+
+	text := g.loadingText
+	if g.loadingSpinner {
+		text = string(spinnerFrames[g.spinnerFrame%len(spinnerFrames)]) + " " + text
+		g.scheduleSpinnerTick()
+	}
+	text = runewidth.Truncate(text, width, "…")
+	textX := x + (width-runewidth.StringWidth(text))/2
+	DrawText(screen, textX, y+height/2, g.style, text)
+}
+
+// drawEmpty fills the grid area and shows the empty-state message centered in a dimmed style.
+func (g *Grid) drawEmpty(screen tcell.Screen, x, y, width, height int) {
+	Fill(screen, x, y, width, height, ' ', g.style)
+
+	text := runewidth.Truncate(g.emptyText, width, "…")
+	textX := x + (width-runewidth.StringWidth(text))/2
+	DrawText(screen, textX, y+height/2, g.style.Dim(true), text)
+}
+
+// scheduleSpinnerTick queues the next spinner frame on the main loop, if not already queued.
+func (g *Grid) scheduleSpinnerTick() {
+	if g.spinnerTimer != nil || g.app == nil {
+		return // Already animating, or no application to run the timer on
+	}
+	var timer *time.Timer
+	timer = g.app.AfterFunc(spinnerInterval, func(app *Application) {
+		if g.spinnerTimer != timer {
+			return // Superseded by a newer tick (loading was toggled meanwhile)
+		}
+		g.spinnerTimer = nil
+		if g.loading && g.loadingSpinner {
+			g.spinnerFrame++
+			g.MarkDirty() // Redraw schedules the next tick
+		}
+	})
+	g.spinnerTimer = timer
 }
 
 // calculateCellWidth determines the required width for cells when autoWidth is enabled.
@@ -617,134 +1853,439 @@ func (g *Grid) calculateCellWidth() int {
 	if g.showIndicator {
 		indicatorSpace = runewidth.RuneWidth(g.indicatorChar)
 	}
-	baseWidth := g.padding + g.padding + indicatorSpace // Left pad + Right pad + Indicator
+	baseWidth := g.padding + g.padding + indicatorSpace // Left pad + Right pad + Indicator
+
+	// Total width is base + max content
+	totalWidth := baseWidth + g.maxContentWidth()
+
+	// Ensure a minimum reasonable width (e.g., padding + 1 char + indicator)
+	minWidth := baseWidth + 1
+	if totalWidth < minWidth {
+		return minWidth
+	}
+
+	return totalWidth
+}
+
+// maxContentWidth returns the width of the widest cell content or header title. The result is
+// cached until the cells or header change, since column widths are queried for every drawn cell.
+func (g *Grid) maxContentWidth() int {
+	if g.contentWidth >= 0 {
+		return g.contentWidth
+	}
+	width := 0
+	for _, title := range g.header {
+		width = max(width, runewidth.StringWidth(title))
+	}
+	for _, row := range g.cells {
+		for _, cell := range row {
+			width = max(width, runewidth.StringWidth(cell))
+		}
+	}
+	g.contentWidth = width
+	return width
+}
+
+// HandleEvent processes keyboard events for grid navigation and interaction,
+// and mouse events for column resizing.
+func (g *Grid) HandleEvent(event tcell.Event) bool {
+	if mouseEvent, ok := event.(*tcell.EventMouse); ok {
+		if g.loading {
+			return false // No column resizing while the placeholder is shown
+		}
+		return g.handleMouse(mouseEvent)
+	}
+
+	keyEvent, ok := event.(*tcell.EventKey)
+	if !ok {
+		return false // Not a key event
+	}
+
+	if g.loading {
+		return false // Navigation is suspended until the data arrives
+	}
+
+	// Ensure grid has content to navigate/interact with
+	numRows := len(g.cells)
+	numCols := 0
+	if numRows > 0 {
+		numCols = len(g.cells[0])
+	} // Assumes rectangular
+	hasContent := numRows > 0 && numCols > 0
+
+	if !hasContent {
+		return false // Cannot navigate/interact with empty grid
+	}
+
+	// --- Go to row ---
+	if g.gotoInput != nil {
+		return g.handleGotoKey(keyEvent)
+	}
+	if g.gotoEnabled && keyEvent.Key() == tcell.KeyRune && keyEvent.Rune() == g.gotoKey {
+		g.openGoto()
+		return true
+	}
+
+	// --- Clipboard ---
+	if g.clipboard && keyEvent.Modifiers()&^tcell.ModCtrl == 0 {
+		switch keyEvent.Key() {
+		case tcell.KeyCtrlC:
+			g.Copy()
+			return true
+		case tcell.KeyCtrlX:
+			g.Cut()
+			return true
+		case tcell.KeyCtrlV:
+			g.Paste()
+			return true
+		}
+	}
+
+	// --- Navigation ---
+	currentRow, currentCol := g.selectedRow, g.selectedCol
+	// If no selection yet, start at 0,0 for navigation calculations
+	if currentRow < 0 {
+		currentRow = 0
+	}
+	if currentCol < 0 {
+		currentCol = 0
+	}
+
+	newRow, newCol := currentRow, currentCol
+
+	// --- Multi-selection confirm ---
+	if g.confirming() && g.confirmKey.Matches(keyEvent) {
+		g.onConfirm(g.GetInteractedCells())
+		return true
+	}
+
+	// --- Bulk toggles (MultiSelect): Shift+Space toggles the row, Ctrl+Space the column ---
+	if g.selectionMode == MultiSelect && !g.buttonMode && g.selectedRow >= 0 && g.selectedCol >= 0 {
+		if keyEvent.Key() == tcell.KeyRune && keyEvent.Rune() == ' ' && keyEvent.Modifiers()&tcell.ModShift != 0 {
+			g.ToggleRow(g.selectedRow)
+			return true
+		}
+		if keyEvent.Key() == tcell.KeyCtrlSpace {
+			g.ToggleColumn(g.selectedCol)
+			return true
+		}
+	}
+
+	// --- Activation ---
+	if g.isActivateKey(keyEvent) {
+		g.Activate()
+		return true // Event handled (interaction)
+	}
+
+	switch g.resolveAction(keyEvent) {
+	case ActionUp:
+		newRow--
+	case ActionDown:
+		newRow++
+	case ActionLeft:
+		newCol--
+	case ActionRight:
+		newCol++
+	case ActionLineStart:
+		newCol = 0
+	case ActionLineEnd:
+		newCol = numCols - 1
+	case ActionPageUp:
+		newRow -= g.pageRows()
+	case ActionPageDown:
+		newRow += g.pageRows()
+	default:
+		return false // Unhandled key
+	}
+
+	// Without a selection (e.g., after SelectNone), the first navigation key selects the first cell
+	if g.selectedRow < 0 || g.selectedCol < 0 {
+		return g.selectCell(0, 0)
+	}
+
+	// If navigation keys were pressed, attempt to select the new cell
+	// selectCell handles bounds checking and returns true if selection changed
+	changed := g.selectCell(newRow, newCol)
+	if newRow != currentRow {
+		g.notifyReachEdge(newRow - currentRow) // Vertical navigation: report reaching the first/last row
+	}
+	return changed
+}
+
+// handleMouse processes mouse events on the grid. The wheel scrolls one row at a time without
+// moving the selection (see SetTopRow). Pressing the left button on a column's right boundary and
+// dragging resizes that column. The boundary grip is the last cell of the column title with a
+// header (see SetHeader), or the last cell of the column in the top visible row without one; there,
+// releasing the button without resizing counts as a click on the cell. Pressing it anywhere else on
+// a body cell selects the cell, or activates it if it was already selected. Only the press acts on
+// a cell: the Button1 events that follow while the button is held are drag motion.
+// Mouse events only arrive while the application has mouse reporting enabled (see
+// Application.SetMouseEnabled).
+func (g *Grid) handleMouse(ev *tcell.EventMouse) bool {
+	if buttons := ev.Buttons(); buttons&(tcell.WheelUp|tcell.WheelDown) != 0 {
+		if buttons&tcell.WheelUp != 0 {
+			g.SetTopRow(g.topRow - 1)
+		} else {
+			g.SetTopRow(g.topRow + 1)
+		}
+		return true
+	}
+
+	held := ev.Buttons()&tcell.Button1 != 0
+	press := held && !g.mouseDown // First Button1 event after a release
+	g.mouseDown = held
+
+	if g.handleScrollBarMouse(ev, press) {
+		return true
+	}
+
+	mx, my := ev.Position()
+
+	if !held {
+		// Button released: finish any resize in progress
+		if g.resizingCol >= 0 {
+			if g.resizeClickRow >= 0 {
+				g.clickCell(g.resizeClickRow, g.resizingCol) // Headerless grip released unmoved
+			}
+			g.resizingCol, g.resizeClickRow = -1, -1
+			return true
+		}
+		return false
+	}
+
+	// Drag in progress: the column now spans from its start to the pointer
+	if g.resizingCol >= 0 {
+		newWidth := mx - g.columnStartX(g.resizingCol) + 1
+		if newWidth < 1 {
+			newWidth = 1
+		}
+		if g.resizeClickRow >= 0 && newWidth == g.columnWidth(g.resizingCol) {
+			return true // Not resized yet: the press may still be a click
+		}
+		g.resizeClickRow = -1
+		g.SetColumnWidth(g.resizingCol, newWidth)
+		return true
+	}
+
+	if !press {
+		return false // Dragging over cells doesn't select them
+	}
+	col, startX, ok := g.columnAtX(mx)
+	if !ok {
+		return false
+	}
+
+	// New press on the header row: start a resize if it landed on a column boundary
+	_, y, _, _ := g.GetRect()
+	if my >= y && my < y+g.headerHeight() {
+		if mx == startX+g.columnWidth(col)-1 {
+			g.resizingCol = col
+			return true
+		}
+		return false
+	}
+
+	// Press on a body cell: select it, or activate it if it's already selected
+	_, bodyY, _, bodyHeight := g.bodyRect()
+	if my < bodyY || my >= bodyY+bodyHeight {
+		return false
+	}
+	row := g.topRow + (my-bodyY)/max(g.cellHeight, 1)
+	if row >= len(g.cells) {
+		return false // Below the last row
+	}
+
+	// Without a header, the column boundaries on the top visible row are the resize grips
+	if g.header == nil && row == g.topRow && mx == startX+g.columnWidth(col)-1 {
+		g.resizingCol, g.resizeClickRow = col, row
+		return true
+	}
+	g.clickCell(row, col)
+	return true
+}
 
-	// Find the maximum width of cell content
-	maxContentWidth := 0
-	for _, row := range g.cells {
-		for _, cell := range row {
-			width := runewidth.StringWidth(cell)
-			if width > maxContentWidth {
-				maxContentWidth = width
-			}
-		}
+// clickCell handles a mouse click on a body cell: it selects the cell, or activates it if it's
+// already selected.
+func (g *Grid) clickCell(row, col int) {
+	if row == g.selectedRow && col == g.selectedCol {
+		g.Activate()
+	} else {
+		g.selectCell(row, col)
 	}
+}
 
-	// Total width is base + max content
-	totalWidth := baseWidth + maxContentWidth
+// SetScrollBar shows a vertical scrollbar over the grid's right-most column whenever there are more
+// rows than fit. With the mouse, clicking the track pages up or down and dragging the thumb scrolls
+// proportionally; the selection is kept within the visible rows. The mouse interactions need
+// the application's mouse reporting enabled (see Application.SetMouseEnabled).
+func (g *Grid) SetScrollBar(show bool) {
+	if g.scrollBar != show {
+		g.scrollBar = show
+		g.MarkDirty()
+	}
+}
 
-	// Ensure a minimum reasonable width (e.g., padding + 1 char + indicator)
-	minWidth := baseWidth + 1
-	if totalWidth < minWidth {
-		return minWidth
+// SetScrollBarAutoHide shows the scrollbar only while the grid scrolls or the mouse hovers over it,
+// hiding it again after a short delay. Requires the grid to belong to an application.
+func (g *Grid) SetScrollBarAutoHide(autoHide bool) {
+	if g.scrollBarAuto != autoHide {
+		g.scrollBarAuto = autoHide
+		g.scrollBarShown = false
+		g.MarkDirty()
 	}
+}
 
-	return totalWidth
+// scrollBarHideDelay is how long an auto-hiding scrollbar stays visible after the last scroll or hover.
+const scrollBarHideDelay = time.Second
+
+// scrollBarVisible reports whether the scrollbar should currently be drawn (if rows overflow).
+func (g *Grid) scrollBarVisible() bool {
+	return g.scrollBar && (!g.scrollBarAuto || g.scrollBarShown || g.draggingThumb)
 }
 
-// HandleEvent processes keyboard events for grid navigation and interaction.
-func (g *Grid) HandleEvent(event tcell.Event) bool {
-	keyEvent, ok := event.(*tcell.EventKey)
-	if !ok {
-		return false // Not a key event
+// revealScrollBar shows an auto-hiding scrollbar and (re)starts its hide timer.
+func (g *Grid) revealScrollBar() {
+	if !g.scrollBar || !g.scrollBarAuto || g.app == nil {
+		return
+	}
+	if !g.scrollBarShown {
+		g.scrollBarShown = true
+		g.MarkDirty()
+	}
+	if g.scrollBarTimer != nil {
+		g.scrollBarTimer.Stop()
 	}
+	var timer *time.Timer
+	timer = g.app.AfterFunc(scrollBarHideDelay, func(app *Application) {
+		if g.scrollBarTimer != timer {
+			return // Superseded by a later scroll or hover
+		}
+		g.scrollBarTimer = nil
+		if g.draggingThumb {
+			return // Hidden once the drag ends
+		}
+		g.scrollBarShown = false
+		g.MarkDirty()
+	})
+	g.scrollBarTimer = timer
+}
 
-	// Ensure grid has content to navigate/interact with
-	numRows := len(g.cells)
-	numCols := 0
-	if numRows > 0 {
-		numCols = len(g.cells[0])
-	} // Assumes rectangular
-	hasContent := numRows > 0 && numCols > 0
+// scrollBarMetrics returns the thumb's offset from the top of the track, its size, and the
+// largest top row. ok is false when all rows fit and no scrollbar is needed.
+func (g *Grid) scrollBarMetrics() (thumbPos, thumbSize, maxTop int, ok bool) {
+	_, _, _, height := g.bodyRect()
+	visibleRows := height / max(g.cellHeight, 1)
+	total := len(g.cells)
+	if height <= 0 || visibleRows <= 0 || total <= visibleRows {
+		return 0, 0, 0, false
+	}
+	thumbSize = max(height*visibleRows/total, 1)
+	maxTop = total - visibleRows
+	thumbPos = (height - thumbSize) * min(g.topRow, maxTop) / maxTop
+	return thumbPos, thumbSize, maxTop, true
+}
 
-	if !hasContent {
-		return false // Cannot navigate/interact with empty grid
+// drawScrollBar draws the track and thumb over the right-most column of the grid area.
+func (g *Grid) drawScrollBar(screen tcell.Screen, x, y, width, height int) {
+	if !g.scrollBarVisible() || width <= 0 {
+		return
 	}
+	thumbPos, thumbSize, _, ok := g.scrollBarMetrics()
+	if !ok {
+		return
+	}
+	barX := x + width - 1
+	Fill(screen, barX, y, 1, height, RuneVLine, g.scrollBarStyle)
+	Fill(screen, barX, y+thumbPos, 1, thumbSize, '█', g.scrollBarThumbStyle)
+}
 
-	// --- Navigation ---
-	currentRow, currentCol := g.selectedRow, g.selectedCol
-	// If no selection yet, start at 0,0 for navigation calculations
-	if currentRow < 0 {
-		currentRow = 0
+// scrollToRow scrolls so the given row is at the top (clamped), moving the selection
+// into the visible rows if it would otherwise scroll out of view.
+func (g *Grid) scrollToRow(top int) {
+	_, _, maxTop, ok := g.scrollBarMetrics()
+	if !ok {
+		return
 	}
-	if currentCol < 0 {
-		currentCol = 0
+	top = min(max(top, 0), maxTop)
+	if top == g.topRow {
+		return
 	}
+	g.topRow = top
+	g.MarkDirty()
 
-	newRow, newCol := currentRow, currentCol
+	_, bottom := g.VisibleRowRange()
+	if g.selectedRow >= 0 && (g.selectedRow < top || g.selectedRow > bottom) {
+		g.selectCell(min(max(g.selectedRow, top), bottom), g.selectedCol)
+		return // selectCell reports the scroll
+	}
+	g.notifyScroll()
+}
 
-	switch keyEvent.Key() {
-	case tcell.KeyUp:
-		newRow--
-	case tcell.KeyDown:
-		newRow++
-	case tcell.KeyLeft:
-		newCol--
-	case tcell.KeyRight:
-		newCol++
-	case tcell.KeyHome:
-		newCol = 0
-	case tcell.KeyEnd:
-		newCol = numCols - 1
-	case tcell.KeyPgUp:
-		_, _, _, height := g.GetRect()
-		if height <= 0 {
-			height = 1
-		} // Avoid division by zero
-		cellH := g.cellHeight
-		if cellH <= 0 {
-			cellH = 1
-		}
-		pageSize := height / cellH
-		if pageSize <= 0 {
-			pageSize = 1
-		}
-		newRow -= pageSize
-	case tcell.KeyPgDn:
-		_, _, _, height := g.GetRect()
-		if height <= 0 {
-			height = 1
-		}
-		cellH := g.cellHeight
-		if cellH <= 0 {
-			cellH = 1
-		}
-		pageSize := height / cellH
-		if pageSize <= 0 {
-			pageSize = 1
-		}
-		newRow += pageSize
-	case tcell.KeyEnter, tcell.KeyRune: // Check Enter or specific runes
-		if keyEvent.Key() == tcell.KeyEnter || keyEvent.Rune() == ' ' { // Enter or Space for interaction
-			g.toggleCellInteraction()
-			return true // Event handled (interaction)
-		}
-		// Check vim-style navigation runes
-		if keyEvent.Key() == tcell.KeyRune {
-			switch keyEvent.Rune() {
-			case 'k':
-				newRow-- // Up
-			case 'j':
-				newRow++ // Down
-			case 'h':
-				newCol-- // Left
-			case 'l':
-				newCol++ // Right
-			default:
-				return false // Unhandled rune
-			}
-			// Navigation rune handled, proceed to selectCell
-		} else {
-			return false // Unhandled non-rune key
+// handleScrollBarMouse handles thumb drags, track clicks and hover over the scrollbar. press is
+// true for the first Button1 event after a release, so holding the button pages only once.
+// Returns true if the event was consumed.
+func (g *Grid) handleScrollBarMouse(ev *tcell.EventMouse, press bool) bool {
+	if !g.scrollBar {
+		return false
+	}
+	mx, my := ev.Position()
+	x, y, width, height := g.bodyRect()
+	pressed := ev.Buttons()&tcell.Button1 != 0
+
+	// Drag in progress: the thumb follows the pointer
+	if g.draggingThumb {
+		if !pressed {
+			g.draggingThumb = false
+			g.revealScrollBar() // Start the hide delay from the end of the drag
+			g.MarkDirty()
+			return true
 		}
+		if _, thumbSize, maxTop, ok := g.scrollBarMetrics(); ok && height > thumbSize {
+			track := height - thumbSize
+			thumbPos := min(max(my-y-g.thumbDragOffset, 0), track)
+			g.scrollToRow((thumbPos*maxTop + track/2) / track) // Round to the nearest row
+		}
+		return true
+	}
+
+	if mx != x+width-1 || my < y || my >= y+height {
+		return false // Not over the scrollbar column
+	}
+	g.revealScrollBar() // Hovering shows an auto-hiding scrollbar
+	if !pressed || !g.scrollBarVisible() {
+		return false
+	}
+	if !press {
+		return true // Button held over the track: it already paged on the press
+	}
+	thumbPos, thumbSize, _, ok := g.scrollBarMetrics()
+	if !ok {
+		return false
+	}
 
+	_, bottom := g.VisibleRowRange()
+	page := bottom - g.topRow + 1
+	switch offset := my - y; {
+	case offset < thumbPos:
+		g.scrollToRow(g.topRow - page) // Track above the thumb: page up
+	case offset >= thumbPos+thumbSize:
+		g.scrollToRow(g.topRow + page) // Track below the thumb: page down
 	default:
-		return false // Unhandled key
+		g.draggingThumb = true
+		g.thumbDragOffset = offset - thumbPos
+		g.MarkDirty()
 	}
+	return true
+}
 
-	// If navigation keys were pressed, attempt to select the new cell
-	// selectCell handles bounds checking and returns true if selection changed
-	return g.selectCell(newRow, newCol)
+// columnStartX returns the screen X coordinate where the given column starts,
+// relative to the current horizontal scroll position.
+func (g *Grid) columnStartX(col int) int {
+	x, _, _, _ := g.bodyRect()
+	if col <= g.leftCol {
+		return x
+	}
+	return x + g.columnSpan(g.leftCol, col-1)
 }
 
 // --- Interaction State Methods ---
@@ -821,10 +2362,467 @@ func (g *Grid) GetInteractedCells() [][2]int {
 	return result
 }
 
+// clipboardRange returns the cells copied and cut: the bounding box of the interacted cells,
+// or the selected cell when nothing is interacted. Returns ok=false if there is nothing to copy.
+func (g *Grid) clipboardRange() (top, left, bottom, right int, ok bool) {
+	cells := g.GetInteractedCells()
+	if len(cells) == 0 {
+		if g.selectedRow < 0 || g.selectedCol < 0 {
+			return 0, 0, 0, 0, false
+		}
+		return g.selectedRow, g.selectedCol, g.selectedRow, g.selectedCol, true
+	}
+	top, left, bottom, right = cells[0][0], cells[0][1], cells[0][0], cells[0][1]
+	for _, cell := range cells[1:] {
+		top, bottom = min(top, cell[0]), max(bottom, cell[0])
+		left, right = min(left, cell[1]), max(right, cell[1])
+	}
+	return top, left, bottom, right, true
+}
+
+// Copy places the interacted cells (their bounding box), or the selected cell if none are interacted,
+// on the application clipboard as text with tab-separated columns and newline-separated rows.
+// The whole bounding box is copied, so the pasted block keeps the cells' relative positions.
+func (g *Grid) Copy() {
+	top, left, bottom, right, ok := g.clipboardRange()
+	if !ok || g.app == nil {
+		return
+	}
+	rows := make([]string, 0, bottom-top+1)
+	for row := top; row <= bottom; row++ {
+		rows = append(rows, strings.Join(g.cells[row][left:right+1], "\t"))
+	}
+	g.app.SetClipboard(strings.Join(rows, "\n"))
+}
+
+// Cut copies the same cells as Copy and then clears only the interacted cells, or the selected cell
+// if none are interacted. Other cells inside the copied bounding box are left unchanged.
+func (g *Grid) Cut() {
+	if _, _, _, _, ok := g.clipboardRange(); !ok || g.app == nil {
+		return
+	}
+	g.Copy()
+
+	cells := g.GetInteractedCells()
+	if len(cells) == 0 {
+		cells = [][2]int{{g.selectedRow, g.selectedCol}}
+	}
+	slices.SortFunc(cells, func(a, b [2]int) int { // Clear (and report edits) in row-major order
+		if a[0] != b[0] {
+			return a[0] - b[0]
+		}
+		return a[1] - b[1]
+	})
+	for _, cell := range cells {
+		if cell[0] < len(g.cells) && cell[1] < len(g.cells[cell[0]]) {
+			g.editCell(cell[0], cell[1], "")
+		}
+	}
+}
+
+// Paste writes the application clipboard into the grid starting at the selected cell.
+// Tabs separate columns and newlines separate rows; values falling outside the grid are dropped.
+func (g *Grid) Paste() {
+	if g.app == nil || g.selectedRow < 0 || g.selectedCol < 0 {
+		return
+	}
+	text := strings.ReplaceAll(g.app.Clipboard(), "\r\n", "\n")
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return
+	}
+	numRows, numCols := len(g.cells), g.numCols()
+	for i, line := range strings.Split(text, "\n") {
+		row := g.selectedRow + i
+		if row >= numRows {
+			break // Clip at the bottom edge
+		}
+		for j, value := range strings.Split(line, "\t") {
+			col := g.selectedCol + j
+			if col >= numCols {
+				break // Clip at the right edge
+			}
+			g.editCell(row, col, value)
+		}
+	}
+}
+
+// editCell sets a cell's content, marking the grid dirty and firing onEdit if it changed.
+func (g *Grid) editCell(row, col int, value string) {
+	oldValue := g.cells[row][col]
+	if oldValue == value {
+		return
+	}
+	g.cells[row][col] = value
+	g.contentWidth = -1 // Re-measure for auto width
+	g.MarkDirty()
+	if g.onEdit != nil {
+		g.onEdit(row, col, oldValue, value)
+	}
+}
+
+// SetBaseline records the current interacted cells as the unmodified value. Implements FormField.
+func (g *Grid) SetBaseline() {
+	g.baseline = make(map[string]bool, len(g.interactedCells))
+	for key, interacted := range g.interactedCells {
+		if interacted {
+			g.baseline[key] = true
+		}
+	}
+}
+
+// IsModified reports whether the interacted cells differ from those recorded by SetBaseline
+// (or from none if no baseline was recorded). Selection and scrolling don't count. Implements FormField.
+func (g *Grid) IsModified() bool {
+	count := 0
+	for key, interacted := range g.interactedCells {
+		if !interacted {
+			continue
+		}
+		if !g.baseline[key] {
+			return true
+		}
+		count++
+	}
+	return count != len(g.baseline)
+}
+
 // ClearInteractions resets the interaction state for all cells.
 func (g *Grid) ClearInteractions() {
 	if len(g.interactedCells) > 0 {
 		g.interactedCells = make(map[string]bool) // Reset the map
 		g.MarkDirty()                             // Need redraw if interactions cleared
 	}
+}
+
+// GridState is a snapshot of a Grid's user-driven state (selection, scroll position and
+// interacted cells), suitable for persisting between runs (e.g., with encoding/json).
+type GridState struct {
+	SelectedRow int      `json:"selectedRow"` // -1 if nothing is selected
+	SelectedCol int      `json:"selectedCol"` // -1 if nothing is selected
+	TopRow      int      `json:"topRow"`
+	LeftCol     int      `json:"leftCol"`
+	Interacted  [][2]int `json:"interacted"` // [row, col] pairs, sorted by row then column
+}
+
+// ExportState captures the grid's selection, scroll offsets and interacted cells.
+func (g *Grid) ExportState() GridState {
+	interacted := g.GetInteractedCells()
+	sort.Slice(interacted, func(i, j int) bool {
+		if interacted[i][0] != interacted[j][0] {
+			return interacted[i][0] < interacted[j][0]
+		}
+		return interacted[i][1] < interacted[j][1]
+	})
+	return GridState{
+		SelectedRow: g.selectedRow,
+		SelectedCol: g.selectedCol,
+		TopRow:      g.topRow,
+		LeftCol:     g.leftCol,
+		Interacted:  interacted,
+	}
+}
+
+// ImportState restores state previously captured with ExportState. Entries are validated
+// against the current cell data: a selection of -1 or one that is out of range clears the
+// selection, out-of-range interacted cells are dropped, and scroll offsets are adjusted to keep
+// the selection visible. Selection and cell callbacks
+// are not fired; onScroll is, so lazy-loading grids can fetch the restored window.
+func (g *Grid) ImportState(state GridState) {
+	inRange := func(row, col int) bool {
+		return row >= 0 && row < len(g.cells) && col >= 0 && col < len(g.cells[row])
+	}
+
+	if inRange(state.SelectedRow, state.SelectedCol) {
+		g.selectedRow = state.SelectedRow
+		g.selectedCol = state.SelectedCol
+	} else {
+		g.selectedRow, g.selectedCol = -1, -1 // Nothing selected, or the cell no longer exists
+	}
+	if state.TopRow >= 0 && state.TopRow < len(g.cells) {
+		g.topRow = state.TopRow
+	}
+	if state.LeftCol >= 0 && state.LeftCol < g.numCols() {
+		g.leftCol = state.LeftCol
+	}
+
+	g.interactedCells = make(map[string]bool)
+	for _, cell := range state.Interacted {
+		if !inRange(cell[0], cell[1]) {
+			continue // Cell no longer exists
+		}
+		g.interactedCells[fmt.Sprintf("%d:%d", cell[0], cell[1])] = true
+		if g.selectionMode == SingleSelect {
+			break // Only one interacted cell allowed
+		}
+	}
+
+	g.ensureSelectionVisible()
+	g.MarkDirty()
+}
+
+// SortRows stably reorders the grid's rows using less. The selected row, interacted cells,
+// highlights, search matches and the modification baseline move with their rows.
+// Column selection and scrolling are left unchanged apart from keeping the selection visible.
+func (g *Grid) SortRows(less func(a, b []string) bool) {
+	if less == nil || len(g.cells) < 2 {
+		return
+	}
+
+	// Sort an index permutation so every row-keyed piece of state can be remapped
+	order := make([]int, len(g.cells))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(g.cells[order[i]], g.cells[order[j]])
+	})
+
+	newRow := make([]int, len(order)) // Old row index -> new row index
+	sorted := make([][]string, len(order))
+	for to, from := range order {
+		newRow[from] = to
+		sorted[to] = g.cells[from]
+	}
+	g.cells = sorted
+
+	g.interactedCells = remapCellKeys(g.interactedCells, newRow)
+	g.highlighted = remapCellKeys(g.highlighted, newRow)
+	g.cellStyles = remapCellKeys(g.cellStyles, newRow)
+	if g.baseline != nil {
+		g.baseline = remapCellKeys(g.baseline, newRow)
+	}
+	for i, m := range g.matches {
+		g.matches[i][0] = newRow[m[0]]
+	}
+	slices.SortFunc(g.matches, func(a, b [2]int) int { // Keep matches in row-major order
+		if a[0] != b[0] {
+			return a[0] - b[0]
+		}
+		return a[1] - b[1]
+	})
+	if g.selectedRow >= 0 && g.selectedRow < len(newRow) {
+		g.selectedRow = newRow[g.selectedRow]
+	}
+	if g.markedRow >= 0 && g.markedRow < len(newRow) {
+		g.markedRow = newRow[g.markedRow]
+	}
+
+	g.ensureSelectionVisible()
+	g.MarkDirty()
+}
+
+// SortByColumn sorts the rows by the content of column col, comparing strings unless a
+// comparator was set with SetColumnComparator. Rows too short to have the column compare as
+// an empty string. Like SortRows, the sort is stable and row state moves with the rows.
+func (g *Grid) SortByColumn(col int, ascending bool) {
+	if col < 0 {
+		return
+	}
+	compare := g.comparators[col]
+	if compare == nil {
+		compare = strings.Compare
+	}
+	g.SortRows(func(a, b []string) bool {
+		var va, vb string
+		if col < len(a) {
+			va = a[col]
+		}
+		if col < len(b) {
+			vb = b[col]
+		}
+		if ascending {
+			return compare(va, vb) < 0
+		}
+		return compare(va, vb) > 0
+	})
+}
+
+// SetColumnComparator sets how SortByColumn orders column col, e.g., numerically or by date.
+// compare returns a negative number if a sorts before b, a positive number if after, and 0 if
+// they are equal, like strings.Compare. Pass nil to compare strings again.
+func (g *Grid) SetColumnComparator(col int, compare func(a, b string) int) {
+	if compare == nil {
+		delete(g.comparators, col)
+		return
+	}
+	g.comparators[col] = compare
+}
+
+// remapCellKeys returns a copy of a "row:col" keyed map with each row replaced by newRow[row].
+// Keys that fail to parse or refer to rows outside newRow are dropped.
+func remapCellKeys[V any](keys map[string]V, newRow []int) map[string]V {
+	remapped := make(map[string]V, len(keys))
+	for key, value := range keys {
+		var r, c int
+		if _, err := fmt.Sscanf(key, "%d:%d", &r, &c); err != nil || r < 0 || r >= len(newRow) {
+			continue
+		}
+		remapped[fmt.Sprintf("%d:%d", newRow[r], c)] = value
+	}
+	return remapped
+}
+
+// Find returns the [row, col] coordinates of all cells matching the query, in row-major order.
+// If matchFunc is nil, a case-insensitive substring match is used. The result is remembered
+// for NextMatch/PrevMatch; pass it to HighlightCells to show the matches.
+func (g *Grid) Find(query string, matchFunc func(cell, query string) bool) [][2]int {
+	if matchFunc == nil {
+		lowerQuery := strings.ToLower(query)
+		matchFunc = func(cell, _ string) bool {
+			return strings.Contains(strings.ToLower(cell), lowerQuery)
+		}
+	}
+
+	matches := make([][2]int, 0)
+	for r, row := range g.cells {
+		for c, cell := range row {
+			if matchFunc(cell, query) {
+				matches = append(matches, [2]int{r, c})
+			}
+		}
+	}
+	g.matches = matches
+	return matches
+}
+
+// HighlightCells draws the given cells with the highlight style, replacing any previous highlight.
+// Highlighting is layered under selection and interaction styles. Pass nil to clear it.
+// Pass DefaultStyle to use the theme's HighlightStyle, which follows theme changes.
+func (g *Grid) HighlightCells(cells [][2]int, style Style) {
+	g.highlighted = make(map[string]bool, len(cells))
+	for _, cell := range cells {
+		g.highlighted[fmt.Sprintf("%d:%d", cell[0], cell[1])] = true
+	}
+	g.highlightCustom = style != DefaultStyle
+	if g.highlightCustom {
+		g.highlightStyle = style
+	} else {
+		g.highlightStyle = DefaultHighlightStyle()
+		if g.app != nil {
+			g.highlightStyle = g.app.GetTheme().HighlightStyle()
+		}
+	}
+	g.MarkDirty()
+}
+
+// SetCellStyle gives a single cell its own style (e.g., completed todos or error rows), merged
+// over the cell's state style: the override's foreground, background and attributes apply to
+// normal cells, while selected, interacted, marked and highlighted cells take only its foreground
+// and attributes, keeping their state background. Per-cell styles are cleared by SetCells and
+// follow their rows when the grid is sorted. Out-of-range coordinates are ignored.
+func (g *Grid) SetCellStyle(row, col int, style Style) {
+	if row < 0 || row >= len(g.cells) || col < 0 || col >= len(g.cells[row]) {
+		return
+	}
+	cellKey := fmt.Sprintf("%d:%d", row, col)
+	if current, ok := g.cellStyles[cellKey]; !ok || current != style {
+		g.cellStyles[cellKey] = style
+		g.MarkDirty()
+	}
+}
+
+// ClearCellStyle removes the style set by SetCellStyle from a cell.
+func (g *Grid) ClearCellStyle(row, col int) {
+	cellKey := fmt.Sprintf("%d:%d", row, col)
+	if _, ok := g.cellStyles[cellKey]; ok {
+		delete(g.cellStyles, cellKey)
+		g.MarkDirty()
+	}
+}
+
+// SetMarkedCell marks a cell with a secondary highlight, independent of the selection and the
+// interacted cells (e.g., the source of a pending move, or one side of a comparison). Only one cell
+// is marked at a time. Style precedence: selection > interacted > marked > search highlight > normal.
+// Out-of-range coordinates are ignored.
+func (g *Grid) SetMarkedCell(row, col int) {
+	if row < 0 || row >= len(g.cells) || col < 0 || col >= len(g.cells[row]) {
+		return
+	}
+	if g.markedRow != row || g.markedCol != col {
+		g.markedRow, g.markedCol = row, col
+		g.MarkDirty()
+	}
+}
+
+// GetMarkedCell returns the marked cell's coordinates, with ok=false if no cell is marked.
+func (g *Grid) GetMarkedCell() (row, col int, ok bool) {
+	return g.markedRow, g.markedCol, g.markedRow >= 0 && g.markedCol >= 0
+}
+
+// ClearMarked removes the mark set by SetMarkedCell.
+func (g *Grid) ClearMarked() {
+	if g.markedRow >= 0 || g.markedCol >= 0 {
+		g.markedRow, g.markedCol = -1, -1
+		g.MarkDirty()
+	}
+}
+
+// SetMarkedStyle sets the style of the marked cell. Pass DefaultStyle to follow the theme again.
+func (g *Grid) SetMarkedStyle(style Style) {
+	g.markedCustom = style != DefaultStyle
+	if g.markedCustom {
+		g.markedStyle = style
+	} else {
+		theme := GetTheme()
+		if g.app != nil {
+			theme = g.app.GetTheme()
+		}
+		if theme == nil {
+			theme = NewDefaultTheme()
+		} // Fallback
+		g.markedStyle = markedStyleFor(theme)
+	}
+	g.MarkDirty()
+}
+
+// markedStyleFor returns the theme-derived style of the marked cell: the grid style, underlined and bold.
+func markedStyleFor(theme Theme) Style {
+	return theme.GridStyle().Underline(true).Bold(true)
+}
+
+// NextMatch moves the selection to the first match of the last Find after the selected cell
+// (row-major order), wrapping around. Returns false if there are no matches.
+func (g *Grid) NextMatch() bool {
+	return g.stepMatch(true)
+}
+
+// PrevMatch moves the selection to the last match of the last Find before the selected cell
+// (row-major order), wrapping around. Returns false if there are no matches.
+func (g *Grid) PrevMatch() bool {
+	return g.stepMatch(false)
+}
+
+// stepMatch implements NextMatch/PrevMatch.
+func (g *Grid) stepMatch(forward bool) bool {
+	if len(g.matches) == 0 {
+		return false
+	}
+	// Compare positions in row-major order
+	before := func(a [2]int, b [2]int) bool {
+		return a[0] < b[0] || (a[0] == b[0] && a[1] < b[1])
+	}
+	current := [2]int{g.selectedRow, g.selectedCol}
+
+	target := g.matches[0] // Wrap target going forward
+	if !forward {
+		target = g.matches[len(g.matches)-1] // Wrap target going backward
+	}
+	if forward {
+		for _, m := range g.matches {
+			if before(current, m) {
+				target = m
+				break
+			}
+		}
+	} else {
+		for i := len(g.matches) - 1; i >= 0; i-- {
+			if before(g.matches[i], current) {
+				target = g.matches[i]
+				break
+			}
+		}
+	}
+	g.selectCell(target[0], target[1])
+	return true
 }
\ No newline at end of file
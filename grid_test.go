@@ -0,0 +1,308 @@
+// grid_test.go
+package tinytui
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestGridImportStateSelection(t *testing.T) {
+	tests := []struct {
+		name             string
+		row, col         int
+		wantRow, wantCol int
+	}{
+		{"in range", 1, 1, 1, 1},
+		{"no selection", -1, -1, -1, -1},
+		{"row out of range", 5, 0, -1, -1},
+		{"column out of range", 0, 2, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid := NewGrid()
+			grid.SetCells([][]string{{"a", "b"}, {"c", "d"}})
+			grid.SetRect(0, 0, 20, 5)
+			grid.Focus() // Selects the first cell
+
+			grid.ImportState(GridState{SelectedRow: tt.row, SelectedCol: tt.col})
+			if row, col, _ := grid.GetSelectedCell(); row != tt.wantRow || col != tt.wantCol {
+				t.Errorf("selection %d,%d after import, want %d,%d", row, col, tt.wantRow, tt.wantCol)
+			}
+		})
+	}
+}
+
+func TestGridGotoPromptPassesUnusedKeys(t *testing.T) {
+	app, layout, inputs := newFocusTestApp(t, 1, 0)
+	grid := NewGrid()
+	grid.SetCells([][]string{{"a"}, {"b"}, {"c"}})
+	grid.SetGotoEnabled(true)
+	pane := NewPane()
+	pane.SetChild(grid)
+	layout.AddPane(pane, Size{Proportion: 1})
+	layout.SetRect(0, 0, 80, 10)
+	app.SetFocus(grid)
+
+	typeRune := func(r rune) bool {
+		return grid.HandleEvent(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+	}
+	typeRune(':')
+	if grid.gotoInput == nil {
+		t.Fatal("goto prompt did not open")
+	}
+	if !typeRune('2') {
+		t.Error("digit not consumed by the prompt")
+	}
+	if typeRune('x') {
+		t.Error("non-digit rune consumed by the prompt")
+	}
+	if grid.HandleEvent(tcell.NewEventKey(tcell.KeyPgDn, 0, tcell.ModCtrl)) {
+		t.Error("Ctrl+PgDn consumed by the prompt")
+	}
+
+	pressKey(app, tcell.KeyTab)
+	if app.GetFocusedComponent() != inputs[0] {
+		t.Errorf("Tab left focus on %T, want the other pane's input", app.GetFocusedComponent())
+	}
+	if grid.gotoInput != nil {
+		t.Error("goto prompt still open after the grid lost focus")
+	}
+}
+
+func TestGridFitWidthWithGutterAndScrollBar(t *testing.T) {
+	rows := make([][]string, 20)
+	for i := range rows {
+		rows[i] = []string{"a", "b", "c"}
+	}
+	tests := []struct {
+		name       string
+		rowNumbers bool
+		scrollBar  bool
+		want       int // Width shared by the columns
+	}{
+		{"plain", false, false, 40},
+		{"row numbers", true, false, 37}, // Gutter: two digits plus a space
+		{"row numbers and scrollbar", true, true, 36},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid := NewGrid()
+			grid.SetCells(rows)
+			grid.SetRect(0, 0, 40, 5) // Fewer lines than rows, so the scrollbar is needed
+			grid.SetFitWidth(true)
+			grid.SetShowRowNumbers(tt.rowNumbers)
+			grid.SetScrollBar(tt.scrollBar)
+
+			if got := grid.columnSpan(0, 2); got != tt.want {
+				t.Errorf("columns span %d, want %d", got, tt.want)
+			}
+			grid.selectCell(0, 2) // The last column must fit without scrolling
+			if grid.leftCol != 0 {
+				t.Errorf("left column %d after selecting the last column, want 0", grid.leftCol)
+			}
+		})
+	}
+}
+
+func TestGridSetCellsAfterSetTopRow(t *testing.T) {
+	makeRows := func(first int) [][]string {
+		rows := make([][]string, 100)
+		for i := range rows {
+			rows[i] = []string{strconv.Itoa(first + i)}
+		}
+		return rows
+	}
+	tests := []struct {
+		name    string
+		keyed   bool
+		refresh [][]string
+		wantRow int
+	}{
+		{"kept by index", false, makeRows(0), 50},
+		{"kept by key after rows shift", true, makeRows(-10), 60}, // Row "50" moved down by 10
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid := NewGrid()
+			grid.SetCells(makeRows(0))
+			grid.SetRect(0, 0, 20, 5)
+			if tt.keyed {
+				grid.SetRowKeyFunc(func(row []string) string { return row[0] })
+			}
+			grid.selectCell(50, 0)
+			grid.SetTopRow(80) // Scroll away from the selection, as the mouse wheel does
+
+			grid.SetCells(tt.refresh)
+			row, _, _ := grid.GetSelectedCell()
+			if row != tt.wantRow {
+				t.Fatalf("selected row %d after refresh, want %d", row, tt.wantRow)
+			}
+			if top, bottom := grid.VisibleRowRange(); row < top || row > bottom {
+				t.Errorf("selected row %d outside the visible rows %d..%d", row, top, bottom)
+			}
+		})
+	}
+}
+
+func TestGridMouseResizeGrip(t *testing.T) {
+	mouse := func(grid *Grid, x, y int, buttons tcell.ButtonMask) {
+		grid.HandleEvent(tcell.NewEventMouse(x, y, buttons, tcell.ModNone))
+	}
+	newGrid := func(header bool) *Grid {
+		grid := NewGrid()
+		grid.SetCells([][]string{{"a", "b"}, {"c", "d"}, {"e", "f"}})
+		if header {
+			grid.SetHeader([]string{"A", "B"})
+		}
+		grid.SetRect(0, 0, 40, 5)
+		grid.SetCellSize(6, 1)
+		grid.selectCell(2, 0)
+		return grid
+	}
+
+	t.Run("headerless drag resizes from the top row", func(t *testing.T) {
+		grid := newGrid(false)
+		edge := grid.columnWidth(0) - 1
+		mouse(grid, edge, 0, tcell.Button1)
+		mouse(grid, edge+3, 0, tcell.Button1)
+		mouse(grid, edge+3, 0, tcell.ButtonNone)
+		if got := grid.columnWidth(0); got != edge+4 {
+			t.Errorf("column width %d after drag, want %d", got, edge+4)
+		}
+		if row, col, _ := grid.GetSelectedCell(); row != 2 || col != 0 {
+			t.Errorf("drag moved the selection to %d,%d", row, col)
+		}
+	})
+
+	t.Run("headerless grip released unmoved clicks the cell", func(t *testing.T) {
+		grid := newGrid(false)
+		width := grid.columnWidth(1)
+		edge := grid.columnWidth(0) + width - 1
+		mouse(grid, edge, 0, tcell.Button1)
+		mouse(grid, edge, 0, tcell.ButtonNone)
+		if row, col, _ := grid.GetSelectedCell(); row != 0 || col != 1 {
+			t.Errorf("selection at %d,%d, want 0,1", row, col)
+		}
+		if got := grid.columnWidth(1); got != width {
+			t.Errorf("column width changed to %d by a click", got)
+		}
+	})
+
+	t.Run("with a header body boundaries select", func(t *testing.T) {
+		grid := newGrid(true)
+		width := grid.columnWidth(0)
+		mouse(grid, width-1, 1, tcell.Button1) // Top body row, under the header
+		mouse(grid, width+2, 1, tcell.Button1)
+		mouse(grid, width+2, 1, tcell.ButtonNone)
+		if row, col, _ := grid.GetSelectedCell(); row != 0 || col != 0 {
+			t.Errorf("selection at %d,%d, want 0,0", row, col)
+		}
+		if got := grid.columnWidth(0); got != width {
+			t.Errorf("body drag resized the column to %d", got)
+		}
+	})
+}
+
+func TestGridCtrlCQuitsWithoutSomethingToCopy(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(app *Application, grid *Grid)
+		wantQuit bool
+	}{
+		{"selected cell is copied", func(app *Application, grid *Grid) {}, false},
+		{"loading", func(app *Application, grid *Grid) { grid.SetLoading(true) }, true},
+		{"no selection", func(app *Application, grid *Grid) {
+			grid.ImportState(GridState{SelectedRow: -1, SelectedCol: -1})
+		}, true},
+		{"key prompt pending", func(app *Application, grid *Grid) {
+			app.PromptKey("Continue?", func(ev *tcell.EventKey) bool { return true })
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, layout, _ := newFocusTestApp(t, 1, 0)
+			grid := NewGrid()
+			grid.SetCells([][]string{{"a", "b"}})
+			grid.SetClipboardEnabled(true)
+			pane := NewPane()
+			pane.SetChild(grid)
+			layout.AddPane(pane, Size{Proportion: 1})
+			layout.SetRect(0, 0, 80, 10)
+			app.SetFocus(grid)
+			tt.setup(app, grid)
+
+			pressKey(app, tcell.KeyCtrlC)
+			if quit := app.stopping(); quit != tt.wantQuit {
+				t.Errorf("Ctrl+C stopped the application: %v, want %v", quit, tt.wantQuit)
+			}
+			if !tt.wantQuit && app.Clipboard() != "a" {
+				t.Errorf("clipboard %q, want %q", app.Clipboard(), "a")
+			}
+		})
+	}
+}
+func TestGridHeaderCellHeight(t *testing.T) {
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("simulation screen: %v", err)
+	}
+	t.Cleanup(screen.Fini)
+	screen.SetSize(30, 9)
+
+	rows := make([][]string, 20)
+	for i := range rows {
+		rows[i] = []string{"r" + strconv.Itoa(i)}
+	}
+	grid := NewGrid()
+	grid.SetCells(rows)
+	grid.SetHeader([]string{"Name"})
+	grid.SetCellSize(10, 2)
+	grid.SetRect(0, 0, 30, 9) // The two-line header leaves room for three rows
+	grid.Focus()
+
+	if _, height := grid.MinSize(); height != 4 {
+		t.Errorf("min height %d, want 4 (header and one row)", height)
+	}
+	for range 10 {
+		grid.HandleEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	}
+	grid.Draw(screen)
+	if top, bottom := grid.VisibleRowRange(); top != 8 || bottom != 10 {
+		t.Errorf("visible rows %d..%d, want 8..10", top, bottom)
+	}
+	for y := 0; y < 2; y++ {
+		if _, _, style, _ := screen.GetContent(8, y); style != grid.headerStyle.ToTcell() {
+			t.Errorf("line %d is not drawn in the header style", y)
+		}
+	}
+	if got := screenText(screen, 1, 1, 4); got != "Name" {
+		t.Errorf("title line %q, want the title on the header's middle line", got)
+	}
+	if got := screenText(screen, 1, 3, 2); got != "r8" {
+		t.Errorf("first body row %q, want r8 below the header", got)
+	}
+
+	grid.HandleEvent(tcell.NewEventMouse(2, 1, tcell.Button1, tcell.ModNone)) // Second header line
+	if row, _, _ := grid.GetSelectedCell(); row != 10 {
+		t.Errorf("click on the header selected row %d", row)
+	}
+}
+
+func TestGridHeaderThemeStyle(t *testing.T) {
+	grid := NewGrid()
+	grid.SetHeader([]string{"A"})
+	turbo := NewTurboTheme()
+	grid.ApplyTheme(turbo)
+	if grid.headerStyle != turbo.GridHeaderStyle() {
+		t.Errorf("header style does not follow the theme's GridHeaderStyle")
+	}
+
+	custom := DefaultStyle.Foreground(ColorRed)
+	grid.SetHeaderStyle(custom)
+	grid.ApplyTheme(NewDefaultTheme())
+	if grid.headerStyle != custom {
+		t.Errorf("theme change replaced the custom header style")
+	}
+}
\ No newline at end of file
@@ -0,0 +1,87 @@
+// inspector.go
+//go:build !tinytui_noinspector
+
+package tinytui
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// SetInspectorKey sets the key that toggles the developer inspector overlay. The overlay is drawn
+// above everything in the top-right corner and shows the focused component's type and rectangle,
+// the focused pane's navigation and slot index, the theme name and the frames drawn in the last second.
+// It never takes focus. The inspector is compiled out of builds using the tinytui_noinspector tag,
+// where this method does nothing.
+func (app *Application) SetInspectorKey(combo KeyModCombo) {
+	app.inspector.key = &combo
+}
+
+// handleInspectorKey toggles the inspector overlay when its key is pressed.
+func (app *Application) handleInspectorKey(ev *tcell.EventKey) bool {
+	if app.inspector.key == nil || !app.inspector.key.Matches(ev) {
+		return false
+	}
+	app.inspector.visible = !app.inspector.visible
+	app.inspector.frames = nil
+	app.QueueRedraw()
+	return true
+}
+
+// drawInspector records the frame for the FPS count and draws the inspector overlay, if visible.
+func (app *Application) drawInspector() {
+	if !app.inspector.visible {
+		return
+	}
+
+	// Keep the timestamps of the frames drawn within the last second
+	now := time.Now()
+	frames := app.inspector.frames[:0]
+	for _, t := range app.inspector.frames {
+		if now.Sub(t) < time.Second {
+			frames = append(frames, t)
+		}
+	}
+	app.inspector.frames = append(frames, now)
+
+	lines := []string{"Inspector"}
+	if focused := app.focusedComponent; focused != nil {
+		x, y, w, h := focused.GetRect()
+		lines = append(lines,
+			fmt.Sprintf("Focus: %T", focused),
+			fmt.Sprintf("Rect:  %d,%d %dx%d", x, y, w, h),
+		)
+	} else {
+		lines = append(lines, "Focus: none")
+	}
+	if pane := app.FocusedPane(); pane != nil {
+		lines = append(lines, "Pane:  nav "+strconv.Itoa(app.FocusedPaneNavIndex())+" slot "+strconv.Itoa(pane.slotIndex))
+	}
+	lines = append(lines,
+		"Theme: "+string(app.GetTheme().Name()),
+		"FPS:   "+strconv.Itoa(len(app.inspector.frames)),
+	)
+
+	width, height := app.layoutSize()
+	boxWidth := 0
+	for _, line := range lines {
+		boxWidth = max(boxWidth, runewidth.StringWidth(line))
+	}
+	boxWidth = min(boxWidth+4, width) // Border and a space on each side
+	boxHeight := min(len(lines)+2, height)
+	if boxWidth < 3 || boxHeight < 3 {
+		return // Too small to show anything
+	}
+
+	theme := app.GetTheme()
+	boxX := width - boxWidth
+	Fill(app.screen, boxX, 0, boxWidth, boxHeight, ' ', theme.TextStyle())
+	DrawBox(app.screen, boxX, 0, boxWidth, boxHeight, theme.PaneFocusBorderStyle())
+	for i, line := range lines[:boxHeight-2] {
+		DrawText(app.screen, boxX+2, 1+i, theme.TextStyle(), runewidth.Truncate(line, boxWidth-4, "…"))
+	}
+}
\ No newline at end of file
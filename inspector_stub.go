@@ -0,0 +1,19 @@
+// inspector_stub.go
+//go:build tinytui_noinspector
+
+package tinytui
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// SetInspectorKey does nothing: the inspector overlay is excluded by the tinytui_noinspector build tag.
+func (app *Application) SetInspectorKey(combo KeyModCombo) {}
+
+// handleInspectorKey never handles a key without the inspector.
+func (app *Application) handleInspectorKey(ev *tcell.EventKey) bool {
+	return false
+}
+
+// drawInspector draws nothing without the inspector.
+func (app *Application) drawInspector() {}
\ No newline at end of file
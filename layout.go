@@ -17,6 +17,8 @@ type Layout struct {
 	rect           Rect         // The screen area allocated to this layout
 	app            *Application // Reference to the parent application
 	style          Style        // Background style for the layout area itself (fills gaps between panes)
+	overflow       bool         // Set when the layout is too small to honor its panes' minimum sizes
+	borderMerging  bool         // Adjacent bordered panes share a border line when the gap is 0
 }
 
 // PaneInfo stores a reference to a Pane and its associated layout constraints (Size).
@@ -26,6 +28,18 @@ type PaneInfo struct {
 	Active bool // Is this slot in the 'panes' array currently occupied?
 }
 
+// paneLocation identifies a pane by the layout holding it and its slot index within that layout.
+type paneLocation struct {
+	layout *Layout
+	slot   int
+}
+
+// minPaneSize is the smallest main-axis size (in cells) a pane can be resized down to.
+const minPaneSize = 1
+
+// overflowIndicator is drawn in the bottom-right corner of a layout that cannot honor its panes' minimum sizes.
+const overflowIndicator = '…'
+
 // NewLayout creates a new layout with the specified orientation.
 // Initializes background style from the current theme.
 func NewLayout(orientation Orientation) *Layout {
@@ -219,6 +233,46 @@ func (l *Layout) RemovePane(index int) { // index here refers to slot index
 	}
 }
 
+// Clear removes all panes from the layout at once, resetting their slot and navigation indices.
+// Geometry and navigation indices are recalculated once. If the focused component was inside a
+// removed pane, it is blurred and focus moves to the nearest remaining focusable component (if any).
+func (l *Layout) Clear() {
+	if l.activeCount == 0 {
+		return
+	}
+
+	var focused Component
+	if l.app != nil {
+		focused = l.app.GetFocusedComponent()
+	}
+	lostFocus := false
+
+	for i := range l.panes {
+		if !l.panes[i].Active {
+			continue
+		}
+		if pane := l.panes[i].Pane; pane != nil {
+			if focused != nil && pane.ContainsFocus(focused) {
+				lostFocus = true
+			}
+			pane.setSlotIndex(0) // Reset slot index
+			pane.SetNavIndex(0)  // Ensure nav index is cleared
+		}
+		l.panes[i] = PaneInfo{} // Clear the slot
+	}
+	l.activeCount = 0
+
+	l.calculateLayout() // Recalculate geometry
+
+	if l.app != nil && l.app.GetLayout() != nil {
+		if lostFocus {
+			focused.Blur()
+			l.app.Dispatch(&FindNextFocusCommand{origin: focused})
+		}
+		l.app.Dispatch(&RecalculateNavIndicesCommand{})
+	}
+}
+
 // SetGap sets the spacing (in cells) between panes in the layout.
 func (l *Layout) SetGap(gap int) {
 	if gap < 0 {
@@ -230,6 +284,16 @@ func (l *Layout) SetGap(gap int) {
 	}
 }
 
+// SetBorderMerging enables or disables merging of adjacent pane borders.
+// When enabled and the gap is 0, neighbouring bordered panes overlap by one cell so they share
+// a single border line, and the meeting points are drawn as junctions (┬ ┴ ├ ┤ ┼ or ╦ ╩ ╠ ╣ ╬).
+func (l *Layout) SetBorderMerging(enabled bool) {
+	if l.borderMerging != enabled {
+		l.borderMerging = enabled
+		l.calculateLayout() // Pane rects overlap differently when merging
+	}
+}
+
 // SetMainAxisAlignment sets the alignment of panes along the main axis (Vertical/Horizontal).
 // Affects where panes start if there's extra space along the main axis.
 func (l *Layout) SetMainAxisAlignment(align Alignment) {
@@ -248,6 +312,260 @@ func (l *Layout) SetCrossAxisAlignment(align Alignment) {
 	}
 }
 
+// ResizePane grows (delta > 0) or shrinks (delta < 0) the pane in the given slot along the
+// layout's main axis and recalculates the layout. Fixed-size panes have their FixedSize adjusted;
+// proportional panes are converted to a fixed size based on their current size, letting
+// proportional siblings absorb the difference. If the pane is the only proportional pane,
+// the adjacent fixed-size sibling is resized in the opposite direction instead.
+// Returns true if any size changed.
+func (l *Layout) ResizePane(slotIndex, delta int) bool {
+	if delta == 0 || slotIndex < 0 || slotIndex >= 10 || !l.panes[slotIndex].Active || l.panes[slotIndex].Pane == nil {
+		return false
+	}
+
+	// Determine the space available along the main axis (excluding gaps)
+	mainAxisSize := l.rect.Width
+	if l.orientation == Vertical {
+		mainAxisSize = l.rect.Height
+	}
+	available := mainAxisSize
+	if l.activeCount > 1 {
+		available -= l.gap * (l.activeCount - 1)
+	}
+	if available <= 0 {
+		return false
+	}
+
+	// Sum the minimum space required by siblings: fixed panes keep their size,
+	// proportional panes must keep at least minPaneSize.
+	othersMin := 0
+	hasProportionalSibling := false
+	for i := range l.panes {
+		if i == slotIndex || !l.panes[i].Active || l.panes[i].Pane == nil {
+			continue
+		}
+		if l.panes[i].Size.FixedSize > 0 {
+			othersMin += l.panes[i].Size.FixedSize
+		} else {
+			othersMin += l.paneMinMainSize(l.panes[i].Pane)
+			hasProportionalSibling = true
+		}
+	}
+
+	info := &l.panes[slotIndex]
+	if info.Size.FixedSize > 0 || hasProportionalSibling {
+		// Resize the pane itself, starting from its fixed size or its current rendered size
+		currentSize := info.Size.FixedSize
+		if currentSize <= 0 {
+			currentSize = l.paneMainSize(info.Pane)
+		}
+		newSize := currentSize + delta
+		maxSize := available - othersMin
+		if newSize > maxSize {
+			newSize = maxSize
+		}
+		if paneMin := l.paneMinMainSize(info.Pane); newSize < paneMin {
+			newSize = paneMin
+		}
+		if info.Size.FixedSize > 0 && newSize == info.Size.FixedSize {
+			return false // Already at a limit
+		}
+		info.Size = Size{FixedSize: newSize}
+	} else {
+		// Only proportional pane among fixed siblings: trade space with the adjacent fixed sibling
+		siblingIndex := l.adjacentActiveSlot(slotIndex)
+		if siblingIndex < 0 {
+			return false
+		}
+		sibling := &l.panes[siblingIndex]
+		newSiblingSize := sibling.Size.FixedSize - delta
+		// The resized pane itself must keep at least its minimum size
+		maxSiblingSize := available - (othersMin - sibling.Size.FixedSize) - l.paneMinMainSize(info.Pane)
+		if newSiblingSize > maxSiblingSize {
+			newSiblingSize = maxSiblingSize
+		}
+		if siblingMin := l.paneMinMainSize(sibling.Pane); newSiblingSize < siblingMin {
+			newSiblingSize = siblingMin
+		}
+		if newSiblingSize == sibling.Size.FixedSize {
+			return false
+		}
+		sibling.Size.FixedSize = newSiblingSize
+	}
+
+	l.calculateLayout()
+	if l.app != nil {
+		l.app.QueueRedraw()
+	}
+	return true
+}
+
+// paneMainSize returns the pane's current size along the layout's main axis.
+func (l *Layout) paneMainSize(pane *Pane) int {
+	if l.orientation == Vertical {
+		return pane.rect.Height
+	}
+	return pane.rect.Width
+}
+
+// paneMinMainSize returns the smallest main-axis size a pane can be resized down to:
+// its child's minimum (see Constrained), but never less than minPaneSize.
+func (l *Layout) paneMinMainSize(pane *Pane) int {
+	minWidth, minHeight := pane.MinSize()
+	size := minWidth
+	if l.orientation == Vertical {
+		size = minHeight
+	}
+	if size < minPaneSize {
+		return minPaneSize
+	}
+	return size
+}
+
+// MinSize returns the smallest width and height the layout needs to honor the minimum sizes
+// of all its active panes: minimums add up (plus gaps) along the main axis and the largest
+// minimum wins on the cross axis.
+func (l *Layout) MinSize() (width, height int) {
+	mainTotal, crossMax, count := 0, 0, 0
+	for i := range l.panes {
+		if !l.panes[i].Active || l.panes[i].Pane == nil {
+			continue
+		}
+		paneWidth, paneHeight := l.panes[i].Pane.MinSize()
+		paneMain, paneCross := paneWidth, paneHeight
+		if l.orientation == Vertical {
+			paneMain, paneCross = paneHeight, paneWidth
+		}
+		mainTotal += paneMain
+		if paneCross > crossMax {
+			crossMax = paneCross
+		}
+		count++
+	}
+	if count > 1 {
+		mainTotal += l.gap * (count - 1)
+	}
+	if l.orientation == Vertical {
+		return crossMax, mainTotal
+	}
+	return mainTotal, crossMax
+}
+
+// ComputePreferredSize measures the size the layout needs to show its content, recursively: along
+// the main axis, fixed-size panes count their FixedSize and proportional panes their preferred size
+// (see Pane.PreferredSize), plus gaps; across it, the largest preferred size wins. Useful to size a
+// popup or overlay to its content before showing it.
+func (l *Layout) ComputePreferredSize() (width, height int) {
+	mainTotal, crossMax, count := 0, 0, 0
+	for i := range l.panes {
+		if !l.panes[i].Active || l.panes[i].Pane == nil {
+			continue
+		}
+		paneWidth, paneHeight := l.panes[i].Pane.PreferredSize()
+		paneMain, paneCross := paneWidth, paneHeight
+		if l.orientation == Vertical {
+			paneMain, paneCross = paneHeight, paneWidth
+		}
+		if fixed := l.panes[i].Size.FixedSize; fixed > 0 {
+			paneMain = fixed
+		}
+		mainTotal += paneMain
+		crossMax = max(crossMax, paneCross)
+		count++
+	}
+	if count > 1 {
+		mainTotal += l.gap * (count - 1)
+	}
+	if l.orientation == Vertical {
+		return crossMax, mainTotal
+	}
+	return mainTotal, crossMax
+}
+
+// applyMinimumSizes raises panes below their child's minimum main-axis size, taking the space
+// first from unallocated room and then from panes with slack above their own minimum
+// (proportional panes before fixed ones, last pane first). If the minimums cannot all fit,
+// panes receive their minimum in slot order until space runs out.
+// Returns whether the layout overflows and the net change in allocated space.
+func (l *Layout) applyMinimumSizes(paneSizes map[int]int, order []int, available int) (overflow bool, growth int) {
+	mins := make(map[int]int, len(order))
+	totalMin, totalSize := 0, 0
+	for _, idx := range order {
+		minWidth, minHeight := l.panes[idx].Pane.MinSize()
+		mins[idx] = minWidth
+		if l.orientation == Vertical {
+			mins[idx] = minHeight
+		}
+		totalMin += mins[idx]
+		totalSize += paneSizes[idx]
+	}
+
+	if totalMin > available {
+		// Not enough room: honor minimums in order, later panes get what's left (possibly nothing)
+		remaining := available
+		for _, idx := range order {
+			size := min(mins[idx], remaining)
+			paneSizes[idx] = size
+			remaining -= size
+		}
+		return true, available - totalSize
+	}
+
+	// Raise undersized panes and total up how much space that took
+	deficit := 0
+	for _, idx := range order {
+		if paneSizes[idx] < mins[idx] {
+			deficit += mins[idx] - paneSizes[idx]
+			paneSizes[idx] = mins[idx]
+		}
+	}
+	if deficit == 0 {
+		return false, 0
+	}
+
+	// Use unallocated space first (e.g., fixed panes requesting less than available)
+	unused := available - totalSize
+	if unused > 0 {
+		used := min(unused, deficit)
+		deficit -= used
+		growth = used
+	}
+
+	// Take the rest from panes with slack: proportional panes first, then fixed, last pane first
+	for _, fixed := range []bool{false, true} {
+		for i := len(order) - 1; i >= 0 && deficit > 0; i-- {
+			idx := order[i]
+			if (l.panes[idx].Size.FixedSize > 0) != fixed {
+				continue
+			}
+			slack := paneSizes[idx] - mins[idx]
+			if slack <= 0 {
+				continue
+			}
+			take := min(slack, deficit)
+			paneSizes[idx] -= take
+			deficit -= take
+		}
+	}
+	return false, growth
+}
+
+// adjacentActiveSlot returns the slot index of the next active pane after slotIndex,
+// or the previous one if slotIndex is the last active pane. Returns -1 if none.
+func (l *Layout) adjacentActiveSlot(slotIndex int) int {
+	for i := slotIndex + 1; i < len(l.panes); i++ {
+		if l.panes[i].Active && l.panes[i].Pane != nil {
+			return i
+		}
+	}
+	for i := slotIndex - 1; i >= 0; i-- {
+		if l.panes[i].Active && l.panes[i].Pane != nil {
+			return i
+		}
+	}
+	return -1
+}
+
 // calculateLayout recalculates the position and size of all active child panes
 // based on the layout's orientation, size constraints, gap, and alignment settings.
 func (l *Layout) calculateLayout() {
@@ -371,8 +689,22 @@ func (l *Layout) calculateLayout() {
 		totalAllocatedProportional = 0
 	}
 
+	// --- 3b. Enforce Child Minimum Sizes (see Constrained) ---
+	overflow, minGrowth := l.applyMinimumSizes(paneSizes, activePaneIndicesInOrder, totalAvailablePaneSpace)
+	for _, idx := range activePaneIndicesInOrder { // Cross axis can only be reported, not fixed
+		minWidth, minHeight := l.panes[idx].Pane.MinSize()
+		minCross := minHeight
+		if isVertical {
+			minCross = minWidth
+		}
+		if minCross > crossAxisSize {
+			overflow = true
+		}
+	}
+	l.overflow = overflow
+
 	// --- 4. Calculate and Set Final Rects based on calculated sizes and alignment ---
-	totalAllocatedMainSize := totalAllocatedFixed + totalAllocatedProportional
+	totalAllocatedMainSize := totalAllocatedFixed + totalAllocatedProportional + minGrowth
 	extraMainSpace := totalAvailablePaneSpace - totalAllocatedMainSize // Usually 0, but > 0 if only fixed panes requested less than available
 	if extraMainSpace < 0 {
 		extraMainSpace = 0
@@ -388,6 +720,7 @@ func (l *Layout) calculateLayout() {
 	// Add layout's own origin offset
 	baseX, baseY := l.rect.X, l.rect.Y
 	currentMainPos += 0 // Relative position within layout rect
+	prevBordered := false
 
 	for _, paneArrIndex := range activePaneIndicesInOrder {
 		paneInfo := l.panes[paneArrIndex]
@@ -398,6 +731,17 @@ func (l *Layout) calculateLayout() {
 			paneMainSize = 0
 		} // Ensure non-negative size
 
+		// Overlap with the previous pane so both borders land on the same line
+		mainPos := currentMainPos
+		bordered := pane.border != BorderNone && paneMainSize > 0
+		if l.borderMerging && l.gap == 0 && prevBordered && bordered {
+			mainPos--
+			paneMainSize++
+		}
+		if paneMainSize > 0 {
+			prevBordered = bordered
+		}
+
 		// Calculate cross-axis size and position based on alignment
 		paneCrossSize := 0
 		crossPos := 0 // Position offset along the cross axis, relative to layout rect edge
@@ -433,15 +777,15 @@ func (l *Layout) calculateLayout() {
 		// Determine final X, Y, Width, Height based on orientation and calculated values
 		var paneX, paneY, paneWidth, paneHeight int
 		if isVertical {
-			paneX = baseX + crossPos       // X determined by cross axis position
-			paneY = baseY + currentMainPos // Y determined by main axis position
-			paneWidth = paneCrossSize      // Width is cross axis size
-			paneHeight = paneMainSize      // Height is main axis size
+			paneX = baseX + crossPos  // X determined by cross axis position
+			paneY = baseY + mainPos   // Y determined by main axis position
+			paneWidth = paneCrossSize // Width is cross axis size
+			paneHeight = paneMainSize // Height is main axis size
 		} else { // Horizontal
-			paneX = baseX + currentMainPos // X determined by main axis position
-			paneY = baseY + crossPos       // Y determined by cross axis position
-			paneWidth = paneMainSize       // Width is main axis size
-			paneHeight = paneCrossSize     // Height is cross axis size
+			paneX = baseX + mainPos    // X determined by main axis position
+			paneY = baseY + crossPos   // Y determined by cross axis position
+			paneWidth = paneMainSize   // Width is main axis size
+			paneHeight = paneCrossSize // Height is cross axis size
 		}
 
 		// Set the calculated rectangle for the child pane
@@ -449,7 +793,7 @@ func (l *Layout) calculateLayout() {
 
 		// Advance position for the next pane, including the gap (only if size > 0)
 		if paneMainSize > 0 {
-			currentMainPos += paneMainSize + l.gap
+			currentMainPos = mainPos + paneMainSize + l.gap
 		}
 	}
 }
@@ -464,6 +808,7 @@ func (l *Layout) Draw(screen tcell.Screen) {
 	focusedComp := l.app.GetFocusedComponent() // Okay if app is nil
 
 	// Draw each active pane
+	var focusedPane *Pane
 	for i := range l.panes {
 		if l.panes[i].Active && l.panes[i].Pane != nil {
 			pane := l.panes[i].Pane
@@ -471,10 +816,112 @@ func (l *Layout) Draw(screen tcell.Screen) {
 			if focusedComp != nil {
 				isChildFocused = pane.ContainsFocus(focusedComp)
 			}
+			if isChildFocused && l.borderMerging {
+				focusedPane = pane // Drawn last so its border style wins on shared lines
+				continue
+			}
 			// Pass only focus info to pane's Draw (no more single pane rule)
 			pane.Draw(screen, isChildFocused)
 		}
 	}
+	if focusedPane != nil {
+		focusedPane.Draw(screen, true)
+	}
+
+	// Shadows darken whatever was drawn beside the panes, so they come last
+	for i := range l.panes {
+		if l.panes[i].Active && l.panes[i].Pane != nil {
+			l.panes[i].Pane.drawShadow(screen, l.rect)
+		}
+	}
+	if l.borderMerging && l.gap == 0 {
+		l.mergeBorderJunctions(screen)
+	}
+
+	// Signal that some content could not be given its minimum size
+	if l.overflow {
+		overflowStyle := DefaultPaneFocusBorderStyle()
+		if l.app != nil {
+			overflowStyle = l.app.GetTheme().PaneFocusBorderStyle()
+		}
+		screen.SetContent(l.rect.X+l.rect.Width-1, l.rect.Y+l.rect.Height-1, overflowIndicator, nil, overflowStyle.ToTcell())
+	}
+}
+
+// mergeBorderJunctions replaces border runes where the borders of adjacent panes meet with the
+// matching junction rune. Only cells on pane border lines are considered, so box-drawing
+// characters inside pane content are left alone.
+func (l *Layout) mergeBorderJunctions(screen tcell.Screen) {
+	// Collect the perimeter cells of every bordered pane
+	borderCells := make(map[[2]int]bool)
+	for i := range l.panes {
+		if !l.panes[i].Active || l.panes[i].Pane == nil || l.panes[i].Pane.border == BorderNone {
+			continue
+		}
+		r := l.panes[i].Pane.rect
+		if r.Width < 2 || r.Height < 2 {
+			continue // Too small to have drawn a border
+		}
+		for x := r.X; x < r.X+r.Width; x++ {
+			borderCells[[2]int{x, r.Y}] = true
+			borderCells[[2]int{x, r.Y + r.Height - 1}] = true
+		}
+		for y := r.Y; y < r.Y+r.Height; y++ {
+			borderCells[[2]int{r.X, y}] = true
+			borderCells[[2]int{r.X + r.Width - 1, y}] = true
+		}
+	}
+
+	// Neighbour offsets paired with the arm pointing at the neighbour and the arm pointing back
+	neighbours := []struct {
+		dx, dy      int
+		arm, facing uint8
+	}{
+		{0, -1, boxArmUp, boxArmDown},
+		{0, 1, boxArmDown, boxArmUp},
+		{-1, 0, boxArmLeft, boxArmRight},
+		{1, 0, boxArmRight, boxArmLeft},
+	}
+
+	// Compute all replacements before writing so each cell sees the original neighbours
+	type junction struct {
+		x, y  int
+		r     rune
+		style tcell.Style
+	}
+	var junctions []junction
+	for cell := range borderCells {
+		mainc, _, style, _ := screen.GetContent(cell[0], cell[1])
+		arms, set := boxRuneArms(mainc)
+		if set == nil {
+			continue // Title, index or other non-line rune
+		}
+		merged := arms
+		for _, n := range neighbours {
+			pos := [2]int{cell[0] + n.dx, cell[1] + n.dy}
+			if !borderCells[pos] {
+				continue
+			}
+			neighbourRune, _, _, _ := screen.GetContent(pos[0], pos[1])
+			if neighbourArms, _ := boxRuneArms(neighbourRune); neighbourArms&n.facing != 0 {
+				merged |= n.arm
+			}
+		}
+		if merged == arms {
+			continue
+		}
+		if r, ok := set[merged]; ok {
+			junctions = append(junctions, junction{cell[0], cell[1], r, style})
+		}
+	}
+	for _, j := range junctions {
+		screen.SetContent(j.x, j.y, j.r, nil, j.style)
+	}
+}
+
+// IsOverflowing reports whether the layout's last calculation could not honor every pane's minimum size.
+func (l *Layout) IsOverflowing() bool {
+	return l.overflow
 }
 
 // ContainsFocus checks recursively if this layout or any of its descendant panes/layouts
@@ -495,6 +942,47 @@ func (l *Layout) ContainsFocus(focused Component) bool {
 	return false // Focus not found in any child pane
 }
 
+// paneAt returns the innermost pane whose rectangle contains the screen position (x, y),
+// searching nested layouts, or nil if no pane is there (e.g., in a gap).
+func (l *Layout) paneAt(x, y int) *Pane {
+	for i := range l.panes {
+		pane := l.panes[i].Pane
+		if !l.panes[i].Active || pane == nil || !pane.rect.contains(x, y) {
+			continue
+		}
+		if childLayout := pane.GetChildLayout(); childLayout != nil {
+			if inner := childLayout.paneAt(x, y); inner != nil {
+				return inner
+			}
+		}
+		return pane
+	}
+	return nil
+}
+
+// findComponentPath returns the chain of pane locations leading from this layout down to the
+// pane that directly holds the given component (outermost first), or nil if not found.
+func (l *Layout) findComponentPath(comp Component) []paneLocation {
+	if comp == nil {
+		return nil
+	}
+	for i := range l.panes {
+		if !l.panes[i].Active || l.panes[i].Pane == nil {
+			continue
+		}
+		pane := l.panes[i].Pane
+		if !pane.ContainsFocus(comp) {
+			continue
+		}
+		path := []paneLocation{{layout: l, slot: i}}
+		if childLayout := pane.GetChildLayout(); childLayout != nil {
+			path = append(path, childLayout.findComponentPath(comp)...)
+		}
+		return path
+	}
+	return nil
+}
+
 // GetPaneBySlotIndex returns the pane at the specified internal slot index (0-9).
 func (l *Layout) GetPaneBySlotIndex(slotIndex int) *Pane {
 	if slotIndex < 0 || slotIndex >= 10 || !l.panes[slotIndex].Active || l.panes[slotIndex].Pane == nil {
@@ -548,6 +1036,23 @@ func (l *Layout) HasDirtyComponents() bool {
 	return false // No dirty components found
 }
 
+// collectDirtyRects appends the rectangles of the dirty panes in this layout to rects and returns it.
+// Panes holding a nested layout are descended into so only the innermost dirty panes are reported.
+func (l *Layout) collectDirtyRects(rects []Rect) []Rect {
+	for i := range l.panes {
+		if !l.panes[i].Active || l.panes[i].Pane == nil {
+			continue
+		}
+		pane := l.panes[i].Pane
+		if childLayout := pane.GetChildLayout(); childLayout != nil && !pane.dirty {
+			rects = childLayout.collectDirtyRects(rects)
+		} else if pane.IsDirty() {
+			rects = append(rects, pane.rect)
+		}
+	}
+	return rects
+}
+
 // ClearAllDirtyFlags recursively clears the dirty flag for all descendant panes and components.
 // Called by the application after a successful draw cycle.
 func (l *Layout) ClearAllDirtyFlags() {
@@ -0,0 +1,65 @@
+// layout_test.go
+package tinytui
+
+import "testing"
+
+// runPendingCommands executes every command queued on the application, as Run would.
+func runPendingCommands(app *Application) {
+	for {
+		select {
+		case cmd := <-app.cmdChan:
+			cmd.Execute(app)
+		default:
+			return
+		}
+	}
+}
+
+func TestLayoutClearThenAddPane(t *testing.T) {
+	app, layout, inputs := newFocusTestApp(t, 3, 1)
+	runPendingCommands(app)
+	old := make([]*Pane, 3)
+	for i := range old {
+		old[i] = layout.GetPaneBySlotIndex(i)
+	}
+
+	layout.Clear()
+	runPendingCommands(app)
+	for i, pane := range old {
+		if pane.GetSlotIndex() != 0 || pane.GetNavIndex() != 0 {
+			t.Errorf("cleared pane %d: slot %d nav %d, want 0 0", i, pane.GetSlotIndex(), pane.GetNavIndex())
+		}
+	}
+	if inputs[1].IsFocused() {
+		t.Error("input in a cleared pane kept focus")
+	}
+
+	// Re-add a cleared pane after a new one to check slots are reused in order
+	fresh := NewPane()
+	fresh.SetChild(NewTextInput())
+	if got := layout.AddPane(fresh, Size{Proportion: 1}); got != 0 {
+		t.Errorf("first AddPane after Clear returned slot %d, want 0", got)
+	}
+	if got := layout.AddPane(old[2], Size{Proportion: 1}); got != 1 {
+		t.Errorf("second AddPane after Clear returned slot %d, want 1", got)
+	}
+	runPendingCommands(app)
+
+	for i, pane := range []*Pane{fresh, old[2]} {
+		if got := pane.GetSlotIndex(); got != i {
+			t.Errorf("pane %d: slot index %d, want %d", i, got, i)
+		}
+		if got := pane.GetNavIndex(); got != i+1 {
+			t.Errorf("pane %d: nav index %d, want %d", i, got, i+1)
+		}
+		if layout.GetPaneBySlotIndex(i) != pane {
+			t.Errorf("GetPaneBySlotIndex(%d) did not return pane %d", i, i)
+		}
+		if layout.GetPaneByNavIndex(i+1) != pane {
+			t.Errorf("GetPaneByNavIndex(%d) did not return pane %d", i+1, i)
+		}
+	}
+	if layout.GetPaneBySlotIndex(2) != nil || layout.GetPaneByNavIndex(3) != nil {
+		t.Error("slot 2 / nav 3 still resolve to a pane after Clear")
+	}
+}
\ No newline at end of file
@@ -0,0 +1,70 @@
+// macro.go
+package tinytui
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// StartRecording begins capturing the key and mouse events processed by the application, e.g.,
+// for scripted demos or reproducible bug reports. Any previous recording is discarded.
+// Call from the main loop (an event handler or a Dispatch command).
+func (app *Application) StartRecording() {
+	app.recording = true
+	app.recorded = nil
+}
+
+// StopRecording stops capturing events and returns the events recorded since StartRecording,
+// in the order they were processed.
+func (app *Application) StopRecording() []tcell.Event {
+	events := app.recorded
+	app.recording = false
+	app.recorded = nil
+	return events
+}
+
+// IsRecording reports whether events are being recorded.
+func (app *Application) IsRecording() bool {
+	return app.recording
+}
+
+// recordEvent appends a key or mouse event to the recording, if one is running.
+func (app *Application) recordEvent(ev tcell.Event) {
+	if !app.recording {
+		return
+	}
+	switch ev.(type) {
+	case *tcell.EventKey, *tcell.EventMouse:
+		app.recorded = append(app.recorded, ev)
+	}
+}
+
+// ReplayEvents feeds recorded events back into the application's event queue from a background
+// goroutine, spaced by their original timestamps divided by speed (2 replays twice as fast; 0 or
+// less sends them without delay). The events are processed on the main loop like terminal input.
+// Replay stops early if the application stops. Safe to call from any goroutine.
+func (app *Application) ReplayEvents(events []tcell.Event, speed float64) {
+	if len(events) == 0 {
+		return
+	}
+	events = append([]tcell.Event(nil), events...) // The caller may reuse the slice
+	go func() {
+		for i, ev := range events {
+			if i > 0 && speed > 0 {
+				if gap := ev.When().Sub(events[i-1].When()); gap > 0 {
+					select {
+					case <-time.After(time.Duration(float64(gap) / speed)):
+					case <-app.stopChan:
+						return
+					}
+				}
+			}
+			select {
+			case app.eventChan <- ev:
+			case <-app.stopChan:
+				return
+			}
+		}
+	}()
+}
\ No newline at end of file
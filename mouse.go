@@ -0,0 +1,119 @@
+// mouse.go
+package tinytui
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// mouseButtons are the button flags of a mouse event that count as a press (wheel flags don't).
+const mouseButtons = tcell.Button1 | tcell.Button2 | tcell.Button3
+
+// mouseWheels are the wheel flags of a mouse event.
+const mouseWheels = tcell.WheelUp | tcell.WheelDown | tcell.WheelLeft | tcell.WheelRight
+
+// SetMouseEnabled turns mouse support on or off (off by default). While enabled, a left click
+// focuses the component under the pointer: a focusable component directly, or the clicked pane's
+// initial focus component when the click lands on its border or on a non-focusable child. Clicks on
+// regions with nothing focusable are ignored and leave the focus as it is. The clicked component
+// also receives the press, and the drag and release that follow it, through HandleEvent
+// (e.g., to resize Grid columns or move a SplitPane divider).
+// Can be called before or after Run.
+func (app *Application) SetMouseEnabled(enabled bool) {
+	app.mouseEnabled = enabled
+	if app.screen == nil {
+		return // Applied by Run
+	}
+	if enabled {
+		app.screen.EnableMouse()
+	} else {
+		app.screen.DisableMouse()
+		app.mouseCapture = nil
+	}
+}
+
+// IsMouseEnabled returns whether mouse support is enabled.
+func (app *Application) IsMouseEnabled() bool {
+	return app.mouseEnabled
+}
+
+// handleMouse routes a mouse event: presses focus and go to the component under the pointer,
+// drags and releases go to the component that received the press, wheel events go to the
+// component under the pointer (focused or not), and motion without a button goes to the focused
+// component while the pointer is over it.
+func (app *Application) handleMouse(ev *tcell.EventMouse) {
+	if app.layout == nil || app.prompt != nil || app.resizeMode {
+		return // No target, or a modal key mode is active
+	}
+	pressed := ev.Buttons()&mouseButtons != 0
+
+	// Drag or release of an earlier press
+	if capture := app.mouseCapture; capture != nil {
+		if !pressed {
+			app.mouseCapture = nil
+		}
+		capture.HandleEvent(ev)
+		return
+	}
+
+	x, y := ev.Position()
+	if ev.Buttons()&mouseWheels != 0 && !pressed {
+		// Wheel: scroll whatever is under the pointer without moving focus
+		if target := app.componentAt(x, y); target != nil && !app.inputBlocked(target) {
+			target.HandleEvent(ev)
+		}
+		return
+	}
+
+	focused := app.GetFocusedComponent()
+	if !pressed || ev.Buttons()&tcell.Button1 == 0 {
+		// Hover or a non-left press: only the focused component under the pointer sees it
+		if focused != nil && componentContains(focused, x, y) && !app.inputBlocked(focused) {
+			if pressed {
+				app.mouseCapture = focused
+			}
+			focused.HandleEvent(ev)
+		}
+		return
+	}
+
+	// Left press: focus what was clicked
+	pane := app.layout.paneAt(x, y)
+	if pane == nil {
+		return // Gap or outside the layout
+	}
+	target, onTarget := app.componentAt(x, y), true
+	if target == nil || !target.Focusable() {
+		target, onTarget = pane.GetInitialFocusComponent(), false // Border or non-focusable child
+	}
+	if target == nil || app.inputBlocked(target) {
+		return // Nothing focusable here: keep the current focus
+	}
+	if trap := app.focusTrapPane(); trap != nil && !trap.ContainsFocus(target) {
+		return // Focus is trapped in another pane
+	}
+	app.SetFocus(target)
+	if onTarget {
+		app.mouseCapture = target
+		target.HandleEvent(ev)
+	}
+}
+
+// componentAt returns the visible component directly held by the innermost pane under the screen
+// position (x, y), or nil if the position is on a border, padding, gap or nested layout.
+func (app *Application) componentAt(x, y int) Component {
+	pane := app.layout.paneAt(x, y)
+	if pane == nil {
+		return nil
+	}
+	comp := pane.GetChildComponent()
+	if comp == nil || !comp.IsVisible() || !componentContains(comp, x, y) {
+		return nil
+	}
+	return comp
+}
+
+// componentContains reports whether the screen position (x, y) lies within the component's rectangle.
+func componentContains(comp Component, x, y int) bool {
+	cx, cy, width, height := comp.GetRect()
+	return Rect{X: cx, Y: cy, Width: width, Height: height}.contains(x, y)
+}
\ No newline at end of file
@@ -2,6 +2,7 @@
 package tinytui
 
 import (
+	"slices"
 	"strconv"
 
 	"github.com/gdamore/tcell/v2"
@@ -12,17 +13,60 @@ import (
 // It manages the child's position relative to the pane's border and can draw the border,
 // title, and user-facing index indicator.
 type Pane struct {
-	child            interface{}  // Holds Component or *Layout
-	border           Border       // Current border type setting (might be overridden by theme focus rule)
-	title            string       // Text displayed in the top border
-	slotIndex        int          // Internal index (0-9) indicating the slot this pane occupies in its parent Layout. 0 if not set.
-	navIndex         int          // User-facing navigation index (1-10), assigned dynamically. 0 if not navigable.
-	rect             Rect         // Position and size allocated to the pane (including border area)
-	style            Style        // Background style for the pane's content area
-	borderStyle      Style        // Style for the border when unfocused (can be overridden by theme)
-	focusBorderStyle Style        // Style for the border when focused (can be overridden by theme)
-	app              *Application // Reference to the parent application
-	dirty            bool         // Does the pane (border, title) or its child need redrawing?
+	child            interface{}    // Holds Component or *Layout
+	border           Border         // Current border type setting (might be overridden by theme focus rule)
+	title            string         // Text displayed in the top border
+	slotIndex        int            // Internal index (0-9) indicating the slot this pane occupies in its parent Layout. 0 if not set.
+	navIndex         int            // User-facing navigation index (1-10), assigned dynamically. 0 if not navigable.
+	rect             Rect           // Position and size allocated to the pane (including border area)
+	style            Style          // Background style for the pane's content area
+	borderStyle      Style          // Style for the border when unfocused (can be overridden by theme)
+	focusBorderStyle Style          // Style for the border when focused (can be overridden by theme)
+	app              *Application   // Reference to the parent application
+	dirty            bool           // Does the pane (border, title) or its child need redrawing?
+	backdrop         *Sprite        // Optional sprite drawn behind the child in the content area
+	backdropTiled    bool           // Repeat the backdrop sprite to fill the content area?
+	anim             *paneAnimation // Running show animation (nil if none)
+	activator        *paneActivator // Focus target standing in for the pane when SetOnActivate is used (nil if unset)
+	shadow           bool           // Darken the cells offset one cell to the bottom-right of the pane?
+	shadowStyle      Style          // Style for the shadow cells (DefaultStyle = darken them)
+	initialFocus     Component      // Descendant focused by Alt+Number / pane cycling (nil = first focusable)
+	inputDisabled    bool           // Is the pane's subtree frozen (no focus, no key events, drawn dimmed)?
+	focusTrap        bool           // Does Tab/Shift+Tab cycle only within the pane while it holds focus?
+	borderFallback   bool           // Draw double/solid borders as single borders on small panes?
+}
+
+// Below these outer dimensions, a pane with SetBorderFallback draws double and solid borders as single.
+const (
+	borderFallbackWidth  = 8
+	borderFallbackHeight = 4
+)
+
+// paneActivator is an invisible component that lets a whole pane take focus and be activated
+// with Enter. It is only offered for focus when the pane's child has nothing focusable.
+type paneActivator struct {
+	BaseComponent
+	onActivate func() // Called when Enter is pressed while the pane has focus
+}
+
+// Focusable returns true while an activation handler is set.
+func (a *paneActivator) Focusable() bool {
+	return a.IsVisible() && a.onActivate != nil
+}
+
+// KeyHints returns the activation key.
+func (a *paneActivator) KeyHints() []KeyHint {
+	return []KeyHint{{Key: "Enter", Description: "activate"}}
+}
+
+// HandleEvent fires the activation handler on Enter.
+func (a *paneActivator) HandleEvent(event tcell.Event) bool {
+	keyEvent, ok := event.(*tcell.EventKey)
+	if !ok || keyEvent.Key() != tcell.KeyEnter || a.onActivate == nil {
+		return false
+	}
+	a.onActivate()
+	return true
 }
 
 // NewPane creates a new pane, initializing styles and border from the current theme.
@@ -38,6 +82,7 @@ func NewPane() *Pane {
 		style:            theme.PaneStyle(),            // Use theme pane background
 		borderStyle:      theme.PaneBorderStyle(),      // Use theme border style
 		focusBorderStyle: theme.PaneFocusBorderStyle(), // Use theme focus border style
+		shadowStyle:      theme.ShadowStyle(),          // Use theme shadow style
 		dirty:            true,                         // Start dirty for initial draw
 		slotIndex:        0,                            // Slot index is assigned by Layout.AddPane
 		navIndex:         0,                            // Navigation index is assigned dynamically
@@ -58,6 +103,7 @@ func (p *Pane) ApplyThemeRecursively(theme Theme) {
 	p.style = theme.PaneStyle()
 	p.borderStyle = theme.PaneBorderStyle()
 	p.focusBorderStyle = theme.PaneFocusBorderStyle()
+	p.shadowStyle = theme.ShadowStyle()
 	p.dirty = true // Mark dirty as appearance might change
 
 	// Apply theme to the child recursively
@@ -135,6 +181,9 @@ func (p *Pane) SetApplication(app *Application) {
 		return
 	} // No change
 	p.app = app
+	if p.activator != nil {
+		p.activator.SetApplication(app)
+	}
 
 	// Propagate to existing child
 	if p.child != nil {
@@ -146,6 +195,112 @@ func (p *Pane) SetApplication(app *Application) {
 	}
 }
 
+// SetOnActivate makes the whole pane act as a button (e.g., a card or tile): while a handler
+// is set the pane can take focus, shows its focus border and calls the handler on Enter.
+// If the pane's child has focusable components they take precedence and the pane itself is
+// not offered for focus. Passing nil removes the handler.
+func (p *Pane) SetOnActivate(handler func()) {
+	if handler == nil {
+		if p.activator == nil {
+			return
+		}
+		activator := p.activator
+		p.activator = nil
+		if activator.IsFocused() && p.app != nil {
+			p.app.Dispatch(&FindNextFocusCommand{origin: activator}) // Pane is no longer focusable
+		}
+	} else {
+		if p.activator == nil {
+			activator := &paneActivator{BaseComponent: NewBaseComponent()}
+			activator.SetApplication(p.app)
+			p.activator = activator
+		}
+		p.activator.onActivate = handler
+	}
+	p.dirty = true
+
+	// Focusability affects navigation index assignment
+	if p.app != nil && p.app.GetLayout() != nil {
+		p.app.Dispatch(&RecalculateNavIndicesCommand{})
+	}
+}
+
+// SetInputEnabled enables or disables input for the pane's whole subtree. A disabled pane stays
+// visible but is drawn dimmed, offers nothing for focus (focus cycling, Alt+Number and pane
+// navigation skip it), and its components receive no key events, which fall through to the
+// application's global handlers instead. Focus inside the pane moves to the next focusable
+// component. Useful to freeze a panel while a "busy" operation runs.
+func (p *Pane) SetInputEnabled(enabled bool) {
+	if p.inputDisabled != !enabled {
+		p.inputDisabled = !enabled
+		p.dirty = true
+		if p.app != nil && p.app.GetLayout() != nil {
+			if focused := p.app.GetFocusedComponent(); !enabled && p.ContainsFocus(focused) {
+				focused.Blur()
+				p.app.Dispatch(&FindNextFocusCommand{origin: focused})
+			}
+			p.app.Dispatch(&RecalculateNavIndicesCommand{}) // Focusability affects navigation indices
+		}
+	}
+}
+
+// SetFocusTrap keeps Tab/Shift+Tab cycling within the pane's focusable components while one of
+// them has focus, for composite widgets built from several components (e.g., a date picker).
+// Escape or Enter not handled by the focused component leaves the trap, moving focus to the next
+// focusable component after the pane. Alt+Number and pane navigation still work, so unlike a modal
+// the rest of the application stays reachable.
+func (p *Pane) SetFocusTrap(trap bool) {
+	p.focusTrap = trap
+}
+
+// IsFocusTrap returns whether focus cycling is trapped within the pane.
+func (p *Pane) IsFocusTrap() bool {
+	return p.focusTrap
+}
+
+// IsInputEnabled returns whether the pane's subtree accepts focus and input (true by default).
+func (p *Pane) IsInputEnabled() bool {
+	return !p.inputDisabled
+}
+
+// SetShadow enables or disables a drop shadow: the cells one column to the right of and one row
+// below the pane are darkened (keeping their content) to give the pane depth. The shadow is drawn
+// by the parent layout after all panes and is clipped to the layout's area.
+func (p *Pane) SetShadow(shadow bool) {
+	if p.shadow != shadow {
+		p.shadow = shadow
+		p.dirty = true
+	}
+}
+
+// drawShadow darkens the cells covered by the pane's shadow within the clip rectangle.
+func (p *Pane) drawShadow(screen tcell.Screen, clip Rect) {
+	r := p.rect
+	if !p.shadow || r.Width <= 0 || r.Height <= 0 {
+		return
+	}
+	screenWidth, screenHeight := screen.Size()
+	darken := func(x, y int) {
+		if x < clip.X || y < clip.Y || x >= clip.X+clip.Width || y >= clip.Y+clip.Height ||
+			x >= screenWidth || y >= screenHeight {
+			return
+		}
+		mainc, combc, style, _ := screen.GetContent(x, y)
+		if p.shadowStyle != DefaultStyle {
+			style = p.shadowStyle.ToTcell() // Fixed shadow style from the theme
+		} else {
+			style = darkenStyle(style)
+		}
+		screen.SetContent(x, y, mainc, combc, style)
+	}
+	for y := r.Y + 1; y <= r.Y+r.Height; y++ {
+		darken(r.X+r.Width, y) // Right edge
+	}
+	for x := r.X + 1; x < r.X+r.Width; x++ {
+		darken(x, r.Y+r.Height) // Bottom edge (corner handled above)
+	}
+}
+
 // SetBorder allows explicitly setting the pane's default (unfocused) border type and style.
 // Note: This overrides the theme's DefaultBorderType and PaneBorderStyle for this pane.
 // The theme's *focused* border type/style might still apply when focused.
@@ -158,6 +313,16 @@ func (p *Pane) SetBorder(border Border, style Style) {
 	}
 }
 
+// SetBorderFallback makes double and solid borders (including the theme's focused border type)
+// degrade to a single border while the pane is narrower than 8 or shorter than 4 cells, so dense
+// layouts stay readable on small terminals. Panes smaller than 2 cells drop the border regardless.
+func (p *Pane) SetBorderFallback(fallback bool) {
+	if p.borderFallback != fallback {
+		p.borderFallback = fallback
+		p.dirty = true
+	}
+}
+
 // SetFocusBorderStyle allows explicitly setting the focused border style.
 // Note: This overrides the theme's PaneFocusBorderStyle for this pane.
 func (p *Pane) SetFocusBorderStyle(style Style) {
@@ -185,6 +350,25 @@ func (p *Pane) SetStyle(style Style) {
 	}
 }
 
+// SetBackgroundSprite sets a sprite drawn in the pane's content area behind the child.
+// Transparent sprite cells show the pane background; opaque child cells draw over the sprite.
+// Pass nil to remove the backdrop.
+func (p *Pane) SetBackgroundSprite(sprite *Sprite) {
+	if p.backdrop != sprite {
+		p.backdrop = sprite
+		p.dirty = true
+	}
+}
+
+// SetBackgroundTiled sets whether the background sprite is repeated to fill the content area
+// (true) or drawn once at the top-left corner (false, the default).
+func (p *Pane) SetBackgroundTiled(tiled bool) {
+	if p.backdropTiled != tiled {
+		p.backdropTiled = tiled
+		p.dirty = true
+	}
+}
+
 // SetRect sets the pane's outer position and size (including any border area).
 // It recalculates and sets the inner rectangle for the child component/layout.
 func (p *Pane) SetRect(x, y, width, height int) {
@@ -278,10 +462,20 @@ func (p *Pane) Draw(screen tcell.Screen, hasFocus bool) {
 			effectiveBorder = theme.DefaultBorderType()
 		}
 	}
+	if p.borderFallback && (effectiveBorder == BorderDouble || effectiveBorder == BorderSolid) &&
+		(rect.Width < borderFallbackWidth || rect.Height < borderFallbackHeight) {
+		effectiveBorder = BorderSingle // Heavy borders look cramped on small panes
+	}
 	if effectiveBorder != BorderNone && (rect.Width < 2 || rect.Height < 2) {
 		effectiveBorder = BorderNone
 	}
 
+	// Highlight the pane targeted by keyboard resize mode
+	isResizing := p.app != nil && p.app.isResizeTarget(p)
+	if isResizing {
+		currentBorderStyle = currentBorderStyle.Reverse(true)
+	}
+
 	// --- Draw Background ---
 	Fill(screen, rect.X, rect.Y, rect.Width, rect.Height, ' ', p.style)
 
@@ -301,7 +495,13 @@ func (p *Pane) Draw(screen tcell.Screen, hasFocus bool) {
 		shouldDisplayIndexIndicator := p.app != nil && p.app.IsShowPaneIndicesEnabled() && p.navIndex > 0
 
 		indexDisplayLen := 0
-		if shouldDisplayIndexIndicator {
+		if isResizing {
+			indexDisplayString = "[resize]" // Resize mode cue replaces the index indicator
+			if titleAreaWidth >= runewidth.StringWidth(indexDisplayString) {
+				DrawText(screen, titleAreaX, titleAreaY, currentBorderStyle, indexDisplayString)
+				indexDisplayLen = runewidth.StringWidth(indexDisplayString)
+			}
+		} else if shouldDisplayIndexIndicator {
 			if hasFocus {
 				indexDisplayString = "[#]" // Focused indicator
 			} else {
@@ -313,7 +513,13 @@ func (p *Pane) Draw(screen tcell.Screen, hasFocus bool) {
 				DrawText(screen, titleAreaX, titleAreaY, currentBorderStyle, indexDisplayString)
 				indexDisplayLen = runewidth.StringWidth(indexDisplayString)
 			}
-		} // If navIndex is 0 or setting disabled, indicator is never drawn.
+		} else if p.app != nil && p.app.showsNonNavigableMarker(p) {
+			indexDisplayString = "[-]" // Debug marker: pane is skipped by Alt+Number
+			if titleAreaWidth >= runewidth.StringWidth(indexDisplayString) {
+				DrawText(screen, titleAreaX, titleAreaY, currentBorderStyle, indexDisplayString)
+				indexDisplayLen = runewidth.StringWidth(indexDisplayString)
+			}
+		} // If navIndex is 0 or setting disabled, indicator is never drawn (unless marked as non-navigable).
 		// --- Removed single-pane logic and [ ] placeholder logic ---
 
 		// --- Title Drawing (Adjusted) ---
@@ -336,15 +542,59 @@ func (p *Pane) Draw(screen tcell.Screen, hasFocus bool) {
 		}
 	} // --- End Border and Index/Title Drawing ---
 
+	// --- Draw Backdrop --- (clipped to the content area, below the child)
+	contentX, contentY, contentWidth, contentHeight := p.getContentRectForBorder(effectiveBorder)
+	if p.backdrop != nil && p.backdrop.IsVisible() && contentWidth > 0 && contentHeight > 0 {
+		p.drawBackdrop(screen, Rect{X: contentX, Y: contentY, Width: contentWidth, Height: contentHeight})
+	}
+
 	// --- Draw Child --- (Logic unchanged)
-	_, _, contentWidth, contentHeight := p.getContentRectForBorder(effectiveBorder)
 	if p.child != nil && contentWidth > 0 && contentHeight > 0 {
 		if comp, ok := p.child.(Component); ok && comp != nil {
-			comp.Draw(screen)
+			drawComponent(screen, comp)
 		} else if layout, ok := p.child.(*Layout); ok && layout != nil {
 			layout.Draw(screen) // Layout draw doesn't need focus info passed down directly here
 		}
 	}
+
+	// --- Dim Disabled Pane --- (content stays visible but reads as inactive)
+	if p.inputDisabled {
+		p.dimContent(screen)
+	}
+
+	// --- Apply Show Animation --- (shifts the finished frame of this pane)
+	if p.anim != nil {
+		p.drawAnimationFrame(screen)
+	}
+}
+
+// dimContent applies the faint attribute to every cell of the pane, marking it as disabled.
+func (p *Pane) dimContent(screen tcell.Screen) {
+	width, height := screen.Size()
+	r := p.rect
+	for y := max(r.Y, 0); y < min(r.Y+r.Height, height); y++ {
+		for x := max(r.X, 0); x < min(r.X+r.Width, width); x++ {
+			mainc, combc, style, _ := screen.GetContent(x, y)
+			screen.SetContent(x, y, mainc, combc, style.Dim(true))
+		}
+	}
+}
+
+// drawBackdrop renders the background sprite into the content rectangle, once or tiled.
+func (p *Pane) drawBackdrop(screen tcell.Screen, content Rect) {
+	spriteWidth, spriteHeight := p.backdrop.Dimensions()
+	if spriteWidth <= 0 || spriteHeight <= 0 {
+		return
+	}
+	if !p.backdropTiled {
+		p.backdrop.drawCells(screen, content.X, content.Y, content)
+		return
+	}
+	for tileY := content.Y; tileY < content.Y+content.Height; tileY += spriteHeight {
+		for tileX := content.X; tileX < content.X+content.Width; tileX += spriteWidth {
+			p.backdrop.drawCells(screen, tileX, tileY, content)
+		}
+	}
 }
 
 // ContainsFocus checks recursively if this pane or its child contains the specified focused component.
@@ -352,6 +602,9 @@ func (p *Pane) ContainsFocus(focused Component) bool {
 	if focused == nil {
 		return false
 	}
+	if p.activator != nil && Component(p.activator) == focused {
+		return true
+	}
 	if p.child == nil {
 		return false
 	}
@@ -368,6 +621,52 @@ func (p *Pane) ContainsFocus(focused Component) bool {
 	return false
 }
 
+// MinSize returns the smallest outer size at which the pane's child renders correctly:
+// the child's minimum (a Constrained component or a nested Layout) plus the border, if any.
+func (p *Pane) MinSize() (width, height int) {
+	switch child := p.child.(type) {
+	case *Layout:
+		if child != nil {
+			width, height = child.MinSize()
+		}
+	case Constrained:
+		if child.IsVisible() {
+			width, height = child.MinSize()
+		}
+	}
+	if p.border != BorderNone && (width > 0 || height > 0) {
+		width += 2
+		height += 2
+	}
+	return width, height
+}
+
+// PreferredSize returns the outer size that fits the pane's content: the child's preferred size
+// (a Measurable component or a nested Layout's ComputePreferredSize, falling back to a Constrained
+// component's minimum) plus the border, if any, and never less than MinSize.
+func (p *Pane) PreferredSize() (width, height int) {
+	switch child := p.child.(type) {
+	case *Layout:
+		if child != nil {
+			width, height = child.ComputePreferredSize()
+		}
+	case Measurable:
+		if child.IsVisible() {
+			width, height = child.PreferredSize()
+		}
+	case Constrained:
+		if child.IsVisible() {
+			width, height = child.MinSize()
+		}
+	}
+	if p.border != BorderNone && (width > 0 || height > 0) {
+		width += 2
+		height += 2
+	}
+	minWidth, minHeight := p.MinSize()
+	return max(width, minWidth), max(height, minHeight)
+}
+
 // HasFocusableChild checks if the pane's child (recursively) contains any focusable component.
 // Used by Draw to determine if the index indicator should potentially be shown.
 func (p *Pane) HasFocusableChild() bool {
@@ -380,6 +679,12 @@ func (p *Pane) IsDirty() bool {
 	if p.dirty {
 		return true
 	} // Pane properties changed
+	if p.backdrop != nil && p.backdrop.IsDirty() {
+		return true
+	} // Backdrop sprite content changed
+	if p.activator != nil && p.activator.IsDirty() {
+		return true
+	} // Pane gained or lost focus through its activator
 
 	// Check if child is dirty (recursively)
 	if p.child != nil {
@@ -397,6 +702,12 @@ func (p *Pane) IsDirty() bool {
 // Called by the layout/application after drawing.
 func (p *Pane) ClearDirtyFlags() {
 	p.dirty = false
+	if p.backdrop != nil {
+		p.backdrop.ClearDirty()
+	}
+	if p.activator != nil {
+		p.activator.ClearDirty()
+	}
 	// Clear child's dirty flag recursively
 	if p.child != nil {
 		if comp, ok := p.child.(Component); ok && comp != nil {
@@ -427,6 +738,9 @@ func (p *Pane) GetChildLayout() *Layout {
 // GetFocusableComponents returns a slice of all focusable components within this pane's child hierarchy.
 // The order depends on the child type (single component or layout's traversal order).
 func (p *Pane) GetFocusableComponents() []Component {
+	if p.inputDisabled {
+		return nil // Disabled subtrees are skipped by focus cycling and navigation
+	}
 	var focusables []Component
 	if p.child != nil {
 		if comp, ok := p.child.(Component); ok && comp != nil {
@@ -439,6 +753,10 @@ func (p *Pane) GetFocusableComponents() []Component {
 			focusables = append(focusables, layout.GetAllFocusableComponents()...)
 		}
 	}
+	// Offer the pane itself only when nothing inside it can take focus
+	if len(focusables) == 0 && p.activator != nil && p.activator.Focusable() {
+		focusables = append(focusables, p.activator)
+	}
 	return focusables
 }
 
@@ -453,6 +771,26 @@ func (p *Pane) GetFirstFocusableComponent() Component {
 	return focusables[0] // Return the first one found
 }
 
+// SetInitialFocus designates the descendant that receives focus when the pane is entered with
+// Alt+Number or FocusNextPane/FocusPrevPane. Pass nil to restore the default (first focusable).
+func (p *Pane) SetInitialFocus(component Component) {
+	p.initialFocus = component
+}
+
+// GetInitialFocusComponent returns the component to focus when entering the pane: the one set with
+// SetInitialFocus if it is currently a focusable descendant, otherwise the first focusable one.
+// Returns nil if the pane has nothing focusable.
+func (p *Pane) GetInitialFocusComponent() Component {
+	focusables := p.GetFocusableComponents()
+	if len(focusables) == 0 {
+		return nil
+	}
+	if p.initialFocus != nil && slices.Contains(focusables, p.initialFocus) {
+		return p.initialFocus
+	}
+	return focusables[0] // Fall back to the first one found
+}
+
 func drawBorderByType(screen tcell.Screen, x, y, width, height int, style Style, borderType Border) {
 	// Let the specific Draw functions handle edge cases like 1x1
 	switch borderType {
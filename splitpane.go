@@ -0,0 +1,491 @@
+// splitpane.go
+package tinytui
+
+import (
+	"math"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// SplitPane shows exactly two components separated by a one-cell divider whose position is
+// adjustable with the keyboard (Alt+arrows along the split axis) or by dragging it with the mouse.
+// It is a simpler alternative to a Layout for the common two-panel case.
+// The split pane is a single focusable component; Tab/Shift+Tab move between its children and all
+// other events go to the child holding focus. Tab past the second child releases focus normally.
+type SplitPane struct {
+	BaseComponent
+	children          [2]Component // First (left/top) and second (right/bottom) child; either may be nil
+	orientation       Orientation  // Horizontal = side by side, Vertical = stacked
+	ratio             float64      // Share of the available space given to the first child (0.0-1.0)
+	minSizes          [2]int       // Minimum size of each child along the split axis
+	current           int          // Index of the child with focus within the split pane (-1 if none)
+	dragging          bool         // Is the divider being dragged with the mouse?
+	mouseDown         bool         // Button 1 is held after a press on a child (drag and release go to the current child)
+	dividerStyle      Style        // Style for the divider line
+	dividerFocusStyle Style        // Style for the divider line while the split pane has focus
+}
+
+// NewSplitPane creates an empty side-by-side split pane with the divider in the middle.
+func NewSplitPane() *SplitPane {
+	theme := GetTheme()
+	if theme == nil {
+		theme = NewDefaultTheme()
+	} // Fallback
+
+	s := &SplitPane{
+		BaseComponent: NewBaseComponent(),
+		orientation:   Horizontal,
+		ratio:         0.5,
+		minSizes:      [2]int{1, 1},
+		current:       -1,
+	}
+	s.ApplyTheme(theme)
+	return s
+}
+
+// ApplyTheme updates the divider styles and applies the theme to both children.
+// Implements ThemedComponent.
+func (s *SplitPane) ApplyTheme(theme Theme) {
+	if theme == nil {
+		return
+	}
+	s.dividerStyle = theme.SeparatorStyle()
+	s.dividerFocusStyle = theme.PaneFocusBorderStyle()
+	for _, child := range s.children {
+		if themed, ok := child.(ThemedComponent); ok {
+			themed.ApplyTheme(theme)
+		}
+	}
+	s.MarkDirty()
+}
+
+// SetApplication links the split pane and both children to the application.
+func (s *SplitPane) SetApplication(app *Application) {
+	s.BaseComponent.SetApplication(app)
+	for _, child := range s.children {
+		if child != nil {
+			child.SetApplication(app)
+		}
+	}
+}
+
+// SetChildren sets the first (left/top) and second (right/bottom) components. Either may be nil.
+// Focus within the split pane moves to the first focusable child.
+func (s *SplitPane) SetChildren(first, second Component) {
+	if s.current >= 0 && s.children[s.current] != nil {
+		s.children[s.current].Blur()
+	}
+	s.children = [2]Component{first, second}
+	for _, child := range s.children {
+		if child == nil {
+			continue
+		}
+		if app := s.App(); app != nil {
+			child.SetApplication(app)
+			if themed, ok := child.(ThemedComponent); ok {
+				themed.ApplyTheme(app.GetTheme())
+			}
+		}
+	}
+	s.current = s.nextFocusable(-1, 1)
+	if s.current >= 0 && s.IsFocused() {
+		s.children[s.current].Focus()
+	}
+	s.layoutChildren()
+	s.MarkDirty()
+
+	if s.app != nil {
+		s.app.Dispatch(&RecalculateNavIndicesCommand{}) // Focusability may have changed
+	}
+}
+
+// Children returns the first and second components.
+func (s *SplitPane) Children() (first, second Component) {
+	return s.children[0], s.children[1]
+}
+
+// SetOrientation sets whether the children are placed side by side (Horizontal) or stacked (Vertical).
+func (s *SplitPane) SetOrientation(orientation Orientation) {
+	if s.orientation != orientation {
+		s.orientation = orientation
+		s.layoutChildren()
+		s.MarkDirty()
+	}
+}
+
+// SetRatio sets the share of the available space (excluding the divider) given to the first child.
+// Values are clamped to 0.0-1.0; minimum sizes still apply.
+func (s *SplitPane) SetRatio(ratio float64) {
+	ratio = max(0, min(ratio, 1))
+	if s.ratio != ratio {
+		s.ratio = ratio
+		s.layoutChildren()
+		s.MarkDirty()
+	}
+}
+
+// Ratio returns the share of the available space given to the first child.
+func (s *SplitPane) Ratio() float64 {
+	return s.ratio
+}
+
+// SetMinSizes sets the minimum size of each child along the split axis (width when Horizontal,
+// height when Vertical). Children implementing Constrained are never shrunk below their MinSize either.
+func (s *SplitPane) SetMinSizes(first, second int) {
+	sizes := [2]int{max(first, 0), max(second, 0)}
+	if s.minSizes != sizes {
+		s.minSizes = sizes
+		s.layoutChildren()
+		s.MarkDirty()
+	}
+}
+
+// GrowFirst moves the divider one cell towards the second child.
+func (s *SplitPane) GrowFirst() {
+	s.moveDivider(1)
+}
+
+// GrowSecond moves the divider one cell towards the first child.
+func (s *SplitPane) GrowSecond() {
+	s.moveDivider(-1)
+}
+
+// moveDivider shifts the divider by delta cells, updating the ratio to match.
+func (s *SplitPane) moveDivider(delta int) {
+	s.setFirstSize(s.firstSize() + delta)
+}
+
+// setFirstSize places the divider so the first child is size cells long (within the minimum sizes).
+func (s *SplitPane) setFirstSize(size int) {
+	available := s.available()
+	if available <= 0 {
+		return
+	}
+	size = s.clampFirstSize(size)
+	s.SetRatio(float64(size) / float64(available))
+}
+
+// available returns the number of cells along the split axis shared by the children.
+func (s *SplitPane) available() int {
+	_, _, width, height := s.GetRect()
+	if s.orientation == Vertical {
+		return max(height-1, 0)
+	}
+	return max(width-1, 0)
+}
+
+// minSize returns the effective minimum size of child i along the split axis.
+func (s *SplitPane) minSize(i int) int {
+	size := s.minSizes[i]
+	if constrained, ok := s.children[i].(Constrained); ok {
+		w, h := constrained.MinSize()
+		if s.orientation == Vertical {
+			size = max(size, h)
+		} else {
+			size = max(size, w)
+		}
+	}
+	return size
+}
+
+// clampFirstSize keeps the first child's size within the minimum sizes of both children.
+// When both minimums cannot be honored, the first child's minimum wins.
+func (s *SplitPane) clampFirstSize(size int) int {
+	available := s.available()
+	size = min(size, available-s.minSize(1))
+	size = max(size, min(s.minSize(0), available))
+	return max(size, 0)
+}
+
+// firstSize returns the size of the first child along the split axis.
+func (s *SplitPane) firstSize() int {
+	return s.clampFirstSize(int(math.Round(float64(s.available()) * s.ratio)))
+}
+
+// dividerPos returns the screen coordinate of the divider along the split axis.
+func (s *SplitPane) dividerPos() int {
+	x, y, _, _ := s.GetRect()
+	if s.orientation == Vertical {
+		return y + s.firstSize()
+	}
+	return x + s.firstSize()
+}
+
+// SetRect sets the split pane's position and size and lays out its children.
+func (s *SplitPane) SetRect(x, y, width, height int) {
+	s.BaseComponent.SetRect(x, y, width, height)
+	s.layoutChildren()
+}
+
+// layoutChildren positions both children on either side of the divider.
+func (s *SplitPane) layoutChildren() {
+	x, y, width, height := s.GetRect()
+	if width <= 0 || height <= 0 {
+		for _, child := range s.children {
+			if child != nil {
+				child.SetRect(x, y, 0, 0)
+			}
+		}
+		return
+	}
+
+	first := s.firstSize()
+	second := max(s.available()-first, 0)
+	rects := [2]Rect{
+		{X: x, Y: y, Width: first, Height: height},
+		{X: x + first + 1, Y: y, Width: second, Height: height},
+	}
+	if s.orientation == Vertical {
+		rects = [2]Rect{
+			{X: x, Y: y, Width: width, Height: first},
+			{X: x, Y: y + first + 1, Width: width, Height: second},
+		}
+	}
+	for i, child := range s.children {
+		if child != nil {
+			child.SetRect(rects[i].X, rects[i].Y, rects[i].Width, rects[i].Height)
+		}
+	}
+}
+
+// MinSize returns the space needed for both children's minimums and the divider.
+// Implements Constrained.
+func (s *SplitPane) MinSize() (width, height int) {
+	along := s.minSize(0) + 1 + s.minSize(1)
+	across := 1
+	for _, child := range s.children {
+		if constrained, ok := child.(Constrained); ok {
+			w, h := constrained.MinSize()
+			if s.orientation == Vertical {
+				across = max(across, w)
+			} else {
+				across = max(across, h)
+			}
+		}
+	}
+	if s.orientation == Vertical {
+		return across, along
+	}
+	return along, across
+}
+
+// Focusable returns true when the split pane is visible and has at least one focusable child.
+func (s *SplitPane) Focusable() bool {
+	return s.IsVisible() && s.nextFocusable(-1, 1) >= 0
+}
+
+// Focus gives focus to the split pane and to its current child.
+func (s *SplitPane) Focus() {
+	s.BaseComponent.Focus()
+	if s.current < 0 || s.children[s.current] == nil || !s.children[s.current].Focusable() {
+		s.current = s.nextFocusable(-1, 1)
+	}
+	if s.current >= 0 {
+		s.children[s.current].Focus()
+	}
+}
+
+// Blur removes focus from the split pane and its current child.
+func (s *SplitPane) Blur() {
+	s.BaseComponent.Blur()
+	if s.current >= 0 && s.children[s.current] != nil {
+		s.children[s.current].Blur()
+	}
+}
+
+// nextFocusable returns the index of the next focusable child after from in the given direction, or -1.
+func (s *SplitPane) nextFocusable(from, direction int) int {
+	for i := from + direction; i >= 0 && i < len(s.children); i += direction {
+		if s.children[i] != nil && s.children[i].Focusable() {
+			return i
+		}
+	}
+	return -1
+}
+
+// moveFocus moves focus within the split pane to the other child in the given direction.
+// Returns false (leaving focus unchanged) when there is no such child.
+func (s *SplitPane) moveFocus(direction int) bool {
+	next := s.nextFocusable(s.current, direction)
+	if next < 0 {
+		return false
+	}
+	if s.current >= 0 && s.children[s.current] != nil {
+		s.children[s.current].Blur()
+	}
+	s.current = next
+	s.children[next].Focus()
+	s.MarkDirty()
+	return true
+}
+
+// KeyHints returns the current child's hints followed by the split pane's own keys.
+func (s *SplitPane) KeyHints() []KeyHint {
+	var hints []KeyHint
+	if s.current >= 0 && s.children[s.current] != nil {
+		hints = append(hints, s.children[s.current].KeyHints()...)
+	}
+	resize := "Alt+←/→"
+	if s.orientation == Vertical {
+		resize = "Alt+↑/↓"
+	}
+	return append(hints,
+		KeyHint{Key: resize, Description: "move divider"},
+		KeyHint{Key: "Tab/Shift+Tab", Description: "switch panel"},
+	)
+}
+
+// IsDirty returns true if the split pane or either child needs redrawing.
+func (s *SplitPane) IsDirty() bool {
+	if s.BaseComponent.IsDirty() {
+		return true
+	}
+	for _, child := range s.children {
+		if child != nil && child.IsDirty() {
+			return true
+		}
+	}
+	return false
+}
+
+// ClearDirty clears the dirty flags of the split pane and both children.
+func (s *SplitPane) ClearDirty() {
+	s.BaseComponent.ClearDirty()
+	for _, child := range s.children {
+		if child != nil {
+			child.ClearDirty()
+		}
+	}
+}
+
+// Draw renders both children and the divider between them.
+func (s *SplitPane) Draw(screen tcell.Screen) {
+	if !s.IsVisible() {
+		return
+	}
+
+	x, y, width, height := s.GetRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	for _, child := range s.children {
+		if child != nil && child.IsVisible() {
+			drawComponent(screen, child)
+		}
+	}
+
+	style := s.dividerStyle
+	if s.IsFocused() || s.dragging {
+		style = s.dividerFocusStyle
+	}
+	pos := s.dividerPos()
+	if s.orientation == Vertical {
+		Fill(screen, x, pos, width, 1, RuneHLine, style)
+	} else {
+		Fill(screen, pos, y, 1, height, RuneVLine, style)
+	}
+}
+
+// HandleEvent moves the divider on Alt+arrow keys and mouse drags; other events go to the
+// focused child first, and unhandled Tab/Shift+Tab switch between the children.
+func (s *SplitPane) HandleEvent(event tcell.Event) bool {
+	if mouseEvent, ok := event.(*tcell.EventMouse); ok {
+		return s.handleMouse(mouseEvent)
+	}
+
+	keyEvent, ok := event.(*tcell.EventKey)
+	if !ok {
+		return false
+	}
+
+	if keyEvent.Modifiers() == tcell.ModAlt {
+		grow, shrink := tcell.KeyRight, tcell.KeyLeft
+		if s.orientation == Vertical {
+			grow, shrink = tcell.KeyDown, tcell.KeyUp
+		}
+		switch keyEvent.Key() {
+		case grow:
+			s.GrowFirst()
+			return true
+		case shrink:
+			s.GrowSecond()
+			return true
+		}
+	}
+
+	if s.current >= 0 && s.children[s.current] != nil {
+		if s.children[s.current].HandleEvent(event) {
+			return true
+		}
+	}
+
+	switch keyEvent.Key() {
+	case tcell.KeyTab:
+		return s.moveFocus(1) // Let the app move focus on from the second child
+	case tcell.KeyBacktab:
+		return s.moveFocus(-1) // Let the app move focus back from the first child
+	}
+	return false
+}
+
+// handleMouse drags the divider when it is pressed with the left button. A left press on a child
+// makes it the current child (moving focus to it) and is passed to it; drag motion and the release
+// that follow go to that child, and other mouse events to the child under the pointer.
+func (s *SplitPane) handleMouse(ev *tcell.EventMouse) bool {
+	mx, my := ev.Position()
+	x, y, _, _ := s.GetRect()
+	pointer, origin := mx, x
+	if s.orientation == Vertical {
+		pointer, origin = my, y
+	}
+
+	if ev.Buttons()&tcell.Button1 == 0 {
+		// Button released: finish any drag in progress
+		if s.dragging {
+			s.dragging = false
+			s.MarkDirty()
+			return true
+		}
+	} else if s.dragging {
+		// Drag in progress: the divider follows the pointer
+		s.setFirstSize(pointer - origin)
+		return true
+	} else if !s.mouseDown && s.contains(mx, my) && pointer == s.dividerPos() {
+		// New press on the divider
+		s.dragging = true
+		s.MarkDirty()
+		return true
+	}
+
+	// Drag motion and the release go to the current child, which received the press
+	held := ev.Buttons()&tcell.Button1 != 0
+	wasDown := s.mouseDown
+	s.mouseDown = held
+	if wasDown && s.current >= 0 && s.children[s.current] != nil {
+		return s.children[s.current].HandleEvent(ev)
+	}
+
+	for i, child := range s.children {
+		if child == nil || !componentContains(child, mx, my) {
+			continue
+		}
+		if held && i != s.current && child.Focusable() {
+			// A press on the other child moves focus to it
+			if s.current >= 0 && s.children[s.current] != nil {
+				s.children[s.current].Blur()
+			}
+			s.current = i
+			child.Focus()
+			s.MarkDirty()
+		}
+		return child.HandleEvent(ev) || held
+	}
+	return false
+}
+
+// contains reports whether the screen position lies within the split pane's rectangle.
+func (s *SplitPane) contains(px, py int) bool {
+	x, y, width, height := s.GetRect()
+	return px >= x && px < x+width && py >= y && py < y+height
+}
\ No newline at end of file
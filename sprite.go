@@ -21,6 +21,9 @@ type Sprite struct {
 	BaseComponent
 	cells [][]SpriteCell // 2D array of cells [row][col]
 	style Style          // Base style applied to the background *behind* transparent sprite cells
+
+	onCellClick func(row, col int) // Called when a cell is clicked (nil = not interactive)
+	mouseDown   bool               // Button 1 is held after a press (drags don't click again)
 }
 
 // NewSprite creates a new sprite component with initial cell data.
@@ -139,9 +142,52 @@ func (s *Sprite) Dimensions() (width, height int) {
 	return width, height
 }
 
-// Focusable returns false, as Sprites are typically non-interactive display elements.
+// Focusable returns true only while a cell click handler is set; otherwise the sprite is a
+// non-interactive display element.
 func (s *Sprite) Focusable() bool {
-	return false
+	return s.onCellClick != nil && s.IsVisible()
+}
+
+// SetOnCellClick makes the sprite an interactive canvas (e.g., a game board or clickable map):
+// a left click inside the sprite calls handler with the clicked cell's row and column in the cell
+// data, accounting for wide runes that span several screen columns. While a handler is set the
+// sprite can take focus. Passing nil removes the handler. Clicks are only delivered while the
+// application has mouse reporting enabled (see Application.SetMouseEnabled).
+func (s *Sprite) SetOnCellClick(handler func(row, col int)) {
+	wasFocusable := s.onCellClick != nil
+	s.onCellClick = handler
+	s.mouseDown = false
+	if wasFocusable == (handler != nil) || s.app == nil {
+		return
+	}
+	if handler == nil && s.IsFocused() {
+		s.app.Dispatch(&FindNextFocusCommand{origin: s}) // Sprite is no longer focusable
+	}
+	if s.app.GetLayout() != nil {
+		s.app.Dispatch(&RecalculateNavIndicesCommand{}) // Focusability affects navigation indices
+	}
+}
+
+// CellAt returns the cell data coordinates drawn at screen position (x, y), mirroring Draw's layout
+// where each cell advances by its rune's display width. ok is false if no cell is drawn there.
+func (s *Sprite) CellAt(x, y int) (row, col int, ok bool) {
+	originX, originY, width, height := s.GetRect()
+	if x < originX || x >= originX+width || y < originY || y >= originY+height {
+		return 0, 0, false
+	}
+	row = y - originY
+	if row >= len(s.cells) {
+		return 0, 0, false
+	}
+	screenX := originX
+	for col, cell := range s.cells[row] {
+		next := screenX + runewidth.RuneWidth(cell.Rune)
+		if x < next {
+			return row, col, x >= screenX
+		}
+		screenX = next
+	}
+	return 0, 0, false
 }
 
 // Draw renders the sprite onto the screen within the component's allocated rectangle.
@@ -162,38 +208,36 @@ func (s *Sprite) Draw(screen tcell.Screen) {
 	// Fill the component's background area first using the sprite's base style
 	Fill(screen, x, y, width, height, ' ', s.style)
 
-	spriteDataHeight := len(s.cells)
-	if spriteDataHeight == 0 {
-		return
-	} // Empty sprite data
+	s.drawCells(screen, x, y, Rect{X: x, Y: y, Width: width, Height: height})
+}
 
+// drawCells draws the sprite's non-transparent cells with their top-left corner at (originX, originY),
+// clipped to the given rectangle. Transparent cells leave whatever is already on screen untouched.
+// Used by Draw and by Pane to render sprite backdrops.
+func (s *Sprite) drawCells(screen tcell.Screen, originX, originY int, clip Rect) {
 	// Get the default background color for transparency check
 	_, defaultBg, _, _ := DefaultStyle.Deconstruct()
 
-	// Determine how much of the sprite data fits within the component's bounds
-	rowsToDraw := min(height, spriteDataHeight)
+	clipRight := clip.X + clip.Width
+	clipBottom := clip.Y + clip.Height
 
 	// Iterate through the rows and columns of the sprite data that fit
-	for row := 0; row < rowsToDraw; row++ {
-		if row >= len(s.cells) {
+	for row, spriteRow := range s.cells {
+		screenY := originY + row // Current drawing position on screen (vertical)
+		if screenY >= clipBottom {
 			break
-		} // Safety check for jagged arrays
-		spriteRow := s.cells[row]
-		spriteDataWidth := len(spriteRow)
-		if spriteDataWidth == 0 {
+		}
+		if screenY < clip.Y {
 			continue
-		} // Skip empty rows
-
-		screenX := x       // Current drawing position on screen (horizontal)
-		screenY := y + row // Current drawing position on screen (vertical)
+		} // Row above the clip area
 
-		for col := 0; col < spriteDataWidth; col++ {
-			// Stop drawing this row if we exceed the component's width
-			if screenX >= x+width {
+		screenX := originX // Current drawing position on screen (horizontal)
+		for _, cell := range spriteRow {
+			// Stop drawing this row if we exceed the clip width
+			if screenX >= clipRight {
 				break
 			}
 
-			cell := spriteRow[col]
 			runeWidth := runewidth.RuneWidth(cell.Rune)
 
 			// A cell is considered transparent if its rune is a space AND
@@ -201,20 +245,14 @@ func (s *Sprite) Draw(screen tcell.Screen) {
 			_, cellBg, _, _ := cell.Style.Deconstruct() // Get the cell's background
 			isTransparent := cell.Rune == ' ' && cellBg == defaultBg
 
-			if !isTransparent {
-				// Cell is not transparent, draw it using its own style
+			if !isTransparent && screenX >= clip.X { // Only draw if start is within the clip area
+				// Cell is not transparent, draw it using its own style (treated as an overlay)
 				effectiveStyle := cell.Style
-				// If background wasn't set, merge with base style? No, treat as overlay.
-				// If cell style has no bg, it uses default term bg.
-
-				// Draw the rune, tcell handles clipping if rune starts inside bounds
-				if screenX >= x { // Only draw if start is within component rect
-					screen.SetContent(screenX, screenY, cell.Rune, nil, effectiveStyle.ToTcell())
-					// Clear subsequent cells if it's a wide rune and within bounds
-					for i := 1; i < runeWidth; i++ {
-						if screenX+i < x+width {
-							screen.SetContent(screenX+i, screenY, ' ', nil, effectiveStyle.ToTcell())
-						}
+				screen.SetContent(screenX, screenY, cell.Rune, nil, effectiveStyle.ToTcell())
+				// Clear subsequent cells if it's a wide rune and within bounds
+				for i := 1; i < runeWidth; i++ {
+					if screenX+i < clipRight {
+						screen.SetContent(screenX+i, screenY, ' ', nil, effectiveStyle.ToTcell())
 					}
 				}
 			}
@@ -224,9 +262,29 @@ func (s *Sprite) Draw(screen tcell.Screen) {
 	}
 }
 
-// HandleEvent processes events. Sprites typically don't handle any events.
+// HandleEvent processes mouse clicks when a cell click handler is set (see SetOnCellClick).
+// Otherwise sprites don't handle any events.
 func (s *Sprite) HandleEvent(event tcell.Event) bool {
-	return false // Not handled
+	mouseEvent, ok := event.(*tcell.EventMouse)
+	if !ok || s.onCellClick == nil {
+		return false // Not handled
+	}
+
+	if mouseEvent.Buttons()&tcell.Button1 == 0 {
+		s.mouseDown = false // Released
+		return false
+	}
+	if s.mouseDown {
+		return true // Still held: a drag is not a new click
+	}
+
+	row, col, ok := s.CellAt(mouseEvent.Position())
+	if !ok {
+		return false // Outside the drawn cells
+	}
+	s.mouseDown = true
+	s.onCellClick(row, col)
+	return true
 }
 
 // Resize changes the sprite's internal cell grid dimensions.
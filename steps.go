@@ -0,0 +1,182 @@
+// steps.go
+package tinytui
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// stepSeparator is drawn between adjacent steps.
+const stepSeparator = " › "
+
+// Steps displays a horizontal breadcrumb of numbered steps, e.g. "① Account › ② Profile › ③ Confirm",
+// for multi-step flows such as wizards. Steps before the current one are drawn as done, the current
+// step is highlighted, and later steps are drawn as upcoming. When the row is too narrow, it scrolls
+// to keep the current step visible and marks hidden steps with an ellipsis. Steps is not focusable.
+type Steps struct {
+	BaseComponent
+	steps         []string // Step labels in order
+	current       int      // Index of the current step (-1 if none)
+	doneStyle     Style    // Style for completed steps
+	currentStyle  Style    // Style for the current step
+	upcomingStyle Style    // Style for steps after the current one (and separators)
+}
+
+// NewSteps creates an empty breadcrumb with styles from the current theme.
+func NewSteps() *Steps {
+	theme := GetTheme()
+	if theme == nil {
+		theme = NewDefaultTheme()
+	} // Fallback
+
+	s := &Steps{
+		BaseComponent: NewBaseComponent(),
+		current:       -1,
+	}
+	s.ApplyTheme(theme)
+	return s
+}
+
+// ApplyTheme updates the step styles based on the provided theme.
+// Implements ThemedComponent.
+func (s *Steps) ApplyTheme(theme Theme) {
+	if theme == nil {
+		return
+	}
+	s.doneStyle = theme.TextStyle()
+	s.currentStyle = theme.TextSelectedStyle()
+	s.upcomingStyle = theme.TextStyle().Dim(true)
+	s.MarkDirty()
+}
+
+// SetSteps replaces the step labels. The current step is kept if it is still in range.
+func (s *Steps) SetSteps(steps []string) {
+	s.steps = append([]string(nil), steps...)
+	if s.current >= len(s.steps) {
+		s.current = len(s.steps) - 1
+	}
+	s.MarkDirty()
+}
+
+// SetCurrent sets the index of the current step, clamped to the steps' range.
+// Pass -1 to mark every step as upcoming, or len(steps) to mark every step as done.
+func (s *Steps) SetCurrent(index int) {
+	index = max(min(index, len(s.steps)), -1)
+	if s.current != index {
+		s.current = index
+		s.MarkDirty()
+	}
+}
+
+// GetCurrent returns the index of the current step (-1 if none).
+func (s *Steps) GetCurrent() int {
+	return s.current
+}
+
+// SetStyles explicitly sets the styles for done, current and upcoming steps, overriding the theme.
+func (s *Steps) SetStyles(done, current, upcoming Style) {
+	s.doneStyle = done
+	s.currentStyle = current
+	s.upcomingStyle = upcoming
+	s.MarkDirty()
+}
+
+// Focusable returns false; the breadcrumb is display-only.
+func (s *Steps) Focusable() bool {
+	return false
+}
+
+// MinSize returns the space needed to show the current step on one line.
+// Implements Constrained.
+func (s *Steps) MinSize() (width, height int) {
+	if s.current >= 0 && s.current < len(s.steps) {
+		return runewidth.StringWidth(s.stepLabel(s.current)), 1
+	}
+	return 1, 1
+}
+
+// PreferredSize returns the space needed to show every step on one line.
+// Implements Measurable.
+func (s *Steps) PreferredSize() (width, height int) {
+	for i := range s.steps {
+		if i > 0 {
+			width += runewidth.StringWidth(stepSeparator)
+		}
+		width += runewidth.StringWidth(s.stepLabel(i))
+	}
+	return max(width, 1), 1
+}
+
+// stepLabel returns the numbered label of step i, e.g. "② Profile".
+// Steps beyond the circled digits ①-⑳ use "(21)" style numbers.
+func (s *Steps) stepLabel(i int) string {
+	number := "(" + strconv.Itoa(i+1) + ")"
+	if i < 20 {
+		number = string(rune('①' + i))
+	}
+	return number + " " + s.steps[i]
+}
+
+// stepStyle returns the style for step i relative to the current step.
+func (s *Steps) stepStyle(i int) Style {
+	switch {
+	case i < s.current:
+		return s.doneStyle
+	case i == s.current:
+		return s.currentStyle
+	default:
+		return s.upcomingStyle
+	}
+}
+
+// Draw renders the steps on the first line of the component's rectangle.
+func (s *Steps) Draw(screen tcell.Screen) {
+	if !s.IsVisible() {
+		return
+	}
+
+	x, y, width, height := s.GetRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	Fill(screen, x, y, width, height, ' ', s.doneStyle)
+	if len(s.steps) == 0 {
+		return
+	}
+
+	// Scroll forward until the current step (if any) fits, leaving room for a leading ellipsis
+	sepWidth := runewidth.StringWidth(stepSeparator)
+	start := 0
+	if s.current > 0 && s.current < len(s.steps) {
+		span := runewidth.StringWidth(s.stepLabel(s.current))
+		for start = s.current; start > 0; start-- {
+			next := span + sepWidth + runewidth.StringWidth(s.stepLabel(start-1))
+			if next+2 > width { // "… " prefix
+				break
+			}
+			span = next
+		}
+	}
+
+	drawX := x
+	if start > 0 {
+		DrawText(screen, drawX, y, s.upcomingStyle, "… ")
+		drawX += 2
+	}
+	for i := start; i < len(s.steps) && drawX < x+width; i++ {
+		if i > start {
+			if drawX+sepWidth >= x+width { // No room for any of the next step: mark the rest hidden
+				DrawText(screen, drawX, y, s.upcomingStyle, runewidth.Truncate(" …", x+width-drawX, ""))
+				break
+			}
+			DrawText(screen, drawX, y, s.upcomingStyle, stepSeparator)
+			drawX += sepWidth
+		}
+		label := runewidth.Truncate(s.stepLabel(i), x+width-drawX, "…")
+		DrawText(screen, drawX, y, s.stepStyle(i), label)
+		drawX += runewidth.StringWidth(label)
+	}
+}
\ No newline at end of file
@@ -207,6 +207,22 @@ func (s Style) MergeWith(other Style) Style {
 	return result
 }
 
+// darkenStyle returns the style with its foreground and background colors darkened, for shadows.
+// Default (terminal) colors, which cannot be darkened, become gray text on black.
+func darkenStyle(style tcell.Style) tcell.Style {
+	fg, bg, _ := style.Decompose()
+	return style.Foreground(darkenColor(fg, ColorGray)).Background(darkenColor(bg, ColorBlack))
+}
+
+// darkenColor returns the color at half brightness, or fallback if it has no known RGB value.
+func darkenColor(c Color, fallback Color) Color {
+	r, g, b := c.RGB()
+	if r < 0 {
+		return fallback
+	}
+	return tcell.NewRGBColor(r/2, g/2, b/2)
+}
+
 // ToTcell converts this tinytui Style back into the underlying tcell.Style
 // required by tcell screen drawing methods.
 func (s Style) ToTcell() tcell.Style {
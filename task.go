@@ -0,0 +1,74 @@
+// task.go
+package tinytui
+
+import (
+	"context"
+	"sync"
+)
+
+// RunTask runs task in a background goroutine and reports back on the main loop, giving a race-free
+// way for long-running work to drive the UI. The task receives a context, cancelled when the returned
+// cancel function is called or the application stops, and a progress function it may call from its
+// goroutine with a completion fraction (e.g., 0.0 to 1.0). Progress updates are delivered to
+// onProgress on the main loop, coalesced so a fast task only reports its latest value; onDone
+// receives the task's error (ctx.Err() after cancellation, if the task returns it). Either callback
+// may be nil. Callbacks are skipped once the application has begun stopping, including those
+// already queued on the main loop at that point.
+func (app *Application) RunTask(task func(ctx context.Context, progress func(fraction float64)) error,
+	onProgress func(app *Application, fraction float64), onDone func(app *Application, err error)) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-app.stopChan:
+			cancel() // Application stopped: cancel the task
+		case <-ctx.Done():
+		}
+	}()
+
+	// Coalesce progress: only one update is queued at a time, carrying the latest value
+	var mu sync.Mutex
+	latest, pending := 0.0, false
+	progress := func(fraction float64) {
+		if onProgress == nil {
+			return
+		}
+		mu.Lock()
+		latest = fraction
+		queue := !pending
+		pending = true
+		mu.Unlock()
+		if queue {
+			app.Dispatch(&SimpleCommand{Func: func(app *Application) {
+				mu.Lock()
+				fraction := latest
+				pending = false
+				mu.Unlock()
+				if !app.stopping() {
+					onProgress(app, fraction)
+				}
+			}})
+		}
+	}
+
+	go func() {
+		err := task(ctx, progress)
+		app.Dispatch(&SimpleCommand{Func: func(app *Application) {
+			cancel() // Release the context's resources
+			if onDone != nil && !app.stopping() {
+				onDone(app, err)
+			}
+		}})
+	}()
+	return cancel
+}
+
+// stopping reports whether Stop has been called. Commands can still be executed after that, since
+// the main loop may pick a queued command before it notices the stop signal.
+func (app *Application) stopping() bool {
+	select {
+	case <-app.stopChan:
+		return true
+	default:
+		return false
+	}
+}
\ No newline at end of file
@@ -0,0 +1,77 @@
+// task_test.go
+package tinytui
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// runTaskCommands executes the next n commands dispatched to the application, waiting for each.
+func runTaskCommands(app *Application, n int) {
+	for i := 0; i < n; i++ {
+		cmd := <-app.cmdChan
+		cmd.Execute(app)
+	}
+}
+
+func TestRunTaskDeliversOnMainLoop(t *testing.T) {
+	app := NewApplication()
+	errFailed := errors.New("failed")
+	reported := make(chan struct{})
+	var progress []float64
+	var doneErr error
+	app.RunTask(func(ctx context.Context, report func(float64)) error {
+		report(0.5)
+		<-reported // Keep the progress update from coalescing with the result
+		return errFailed
+	}, func(app *Application, fraction float64) {
+		progress = append(progress, fraction)
+		close(reported)
+	}, func(app *Application, err error) {
+		doneErr = err
+	})
+
+	runTaskCommands(app, 2) // Progress, then completion
+	if len(progress) != 1 || progress[0] != 0.5 {
+		t.Errorf("progress %v, want [0.5]", progress)
+	}
+	if doneErr != errFailed {
+		t.Errorf("done with %v, want %v", doneErr, errFailed)
+	}
+}
+
+func TestRunTaskSkipsCallbacksAfterStop(t *testing.T) {
+	app := NewApplication()
+	called := false
+	finished := make(chan struct{})
+	app.RunTask(func(ctx context.Context, report func(float64)) error {
+		defer close(finished)
+		return nil
+	}, nil, func(app *Application, err error) {
+		called = true
+	})
+
+	<-finished
+	cmd := <-app.cmdChan // Completion queued before the stop
+	app.Stop()
+	cmd.Execute(app)
+	if called {
+		t.Error("done callback ran after the application stopped")
+	}
+}
+
+func TestRunTaskCancelledByStop(t *testing.T) {
+	app := NewApplication()
+	result := make(chan error, 1)
+	app.RunTask(func(ctx context.Context, report func(float64)) error {
+		<-ctx.Done()
+		result <- ctx.Err()
+		return ctx.Err()
+	}, nil, nil)
+
+	app.Stop()
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("task context ended with %v, want context.Canceled", err)
+	}
+}
\ No newline at end of file
@@ -2,22 +2,65 @@
 package tinytui
 
 import (
+	"regexp"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/mattn/go-runewidth"
 )
 
 // Text displays static or wrapping text content. It is typically not focusable or interactive,
-// serving as a label or display area. Supports basic scrolling.
+// serving as a label or display area. Supports basic scrolling; with SetFocusable(true) it takes
+// focus and scrolls with the keyboard (e.g., for log views).
 type Text struct {
 	BaseComponent
-	content      string
-	wrap         bool          // Should text wrap within component width?
-	lines        []string      // Cache of processed lines (split by newline, potentially wrapped)
-	scrollOffset int           // Index (0-based) of the first visible line
-	style        Style         // Style applied to the text
-	alignment    AlignmentText // Horizontal text alignment (Left, Center, Right)
+	content       string
+	wrap          bool          // Should text wrap within component width?
+	lines         []string      // Cache of processed lines (split by newline, potentially wrapped)
+	scrollOffset  int           // Index (0-based) of the first visible line
+	hScrollOffset int           // Visual column of the first visible cell when not wrapping
+	style         Style         // Style applied to the text
+	alignment     AlignmentText // Horizontal text alignment (Left, Center, Right); top/middle/bottom when vertical
+	vertical      bool          // Stack runes top-to-bottom, one column per line?
+	tabWidth      int           // Distance between tab stops when expanding '\t' (0 = leave tabs as-is)
+	revertTimer   *time.Timer   // Pending revert of transient content (nil if none)
+	focusable     bool          // Can the text take focus and scroll with the keyboard?
+	markup        bool          // Parse style tags such as "[red]" in the content? See SetMarkup.
+	markupSpans   []markupSpan  // Styled runs of the tag-free content lines (markup only)
+
+	// Search state
+	lineOrigins       []lineOrigin   // Source of each cached display line in the raw content
+	searchPattern     *regexp.Regexp // Pattern of the active search (nil if none)
+	matches           []textMatch    // Matches of the active search in the raw content, in order
+	currentMatch      int            // Index of the match selected by NextMatch/PrevMatch (-1 if none)
+	highlightStyle    Style          // Style for matched substrings
+	highlightCustom   bool           // Was highlightStyle set by HighlightMatches (not the theme)?
+	currentMatchStyle Style          // Style for the current match
+
+	// Scroll indicator, drawn while focused
+	scrollBarStyle      Style // Style for the indicator track
+	scrollBarThumbStyle Style // Style for the indicator thumb
+}
+
+// lineOrigin records where a display line starts within the raw content.
+type lineOrigin struct {
+	line   int // Index of the content line (newline-separated, tabs expanded)
+	offset int // Byte offset of the display line within the raw line
+}
+
+// markupSpan is a run of a content line styled by markup tags.
+type markupSpan struct {
+	line       int   // Index of the content line (newline-separated, tags removed, tabs expanded)
+	start, end int   // Byte range of the run within the line
+	style      Style // Tag style, merged over the text style when drawn
+}
+
+// textMatch is a single search match within a raw content line.
+type textMatch struct {
+	line       int // Index of the content line (newline-separated, tabs expanded)
+	start, end int // Byte range of the match within the line
 }
 
 // AlignmentText defines horizontal text alignment options within the component's bounds.
@@ -44,6 +87,8 @@ func NewText(content string) *Text {
 		scrollOffset:  0,
 		style:         theme.TextStyle(), // Use theme default text style
 		alignment:     AlignTextLeft,     // Default alignment
+		tabWidth:      defaultTabWidth,
+		currentMatch:  -1,
 		// lines cache starts nil, calculated on first Draw or Scroll
 	}
 	// Apply theme initially to set the style correctly
@@ -62,11 +107,29 @@ func (t *Text) ApplyTheme(theme Theme) {
 		t.style = newStyle
 		t.MarkDirty() // Style change requires redraw
 	}
+	if !t.highlightCustom && t.highlightStyle != theme.HighlightStyle() {
+		t.highlightStyle = theme.HighlightStyle()
+		t.MarkDirty()
+	}
+	if t.currentMatchStyle != theme.TextSelectedStyle() {
+		t.currentMatchStyle = theme.TextSelectedStyle()
+		t.MarkDirty()
+	}
+	if t.markup {
+		t.lines = nil // Theme tags (e.g., "[highlight]") resolve against the new theme
+		t.MarkDirty()
+	}
+	if t.scrollBarStyle != theme.ScrollBarStyle() || t.scrollBarThumbStyle != theme.ScrollBarThumbStyle() {
+		t.scrollBarStyle = theme.ScrollBarStyle()
+		t.scrollBarThumbStyle = theme.ScrollBarThumbStyle()
+		t.MarkDirty()
+	}
 }
 
 // SetContent updates the text displayed by the component.
 // Resets the line cache and scroll position.
 func (t *Text) SetContent(content string) {
+	t.cancelRevert() // Explicit content replaces any transient message
 	if t.content == content {
 		return
 	} // No change
@@ -74,9 +137,40 @@ func (t *Text) SetContent(content string) {
 	t.content = content
 	t.lines = nil      // Invalidate line cache, needs recalculation
 	t.scrollOffset = 0 // Reset scroll offset when content changes
+	t.hScrollOffset = 0
+	if t.searchPattern != nil {
+		t.findMatches() // Keep an active search up to date with the new content
+	}
 	t.MarkDirty()
 }
 
+// SetTransientContent shows msg and, after d, replaces it with revertTo (e.g., "Saved!" then "Ready").
+// The revert runs on the application's main loop, so the component must belong to an application;
+// otherwise msg is shown and not reverted. A later SetContent or SetTransientContent call cancels
+// a pending revert.
+func (t *Text) SetTransientContent(msg string, d time.Duration, revertTo string) {
+	t.SetContent(msg)
+	if t.app == nil {
+		return // No main loop to run the revert on
+	}
+	var timer *time.Timer
+	timer = t.app.AfterFunc(d, func(app *Application) {
+		if t.revertTimer != timer {
+			return // Superseded by newer content
+		}
+		t.SetContent(revertTo)
+	})
+	t.revertTimer = timer
+}
+
+// cancelRevert stops a pending transient content revert, if any.
+func (t *Text) cancelRevert() {
+	if t.revertTimer != nil {
+		t.revertTimer.Stop()
+		t.revertTimer = nil
+	}
+}
+
 // GetContent returns the raw, unprocessed text content assigned to the component.
 func (t *Text) GetContent() string {
 	return t.content
@@ -90,7 +184,8 @@ func (t *Text) SetWrap(wrap bool) {
 	} // No change
 
 	t.wrap = wrap
-	t.lines = nil // Invalidate line cache, wrapping changes line breaks
+	t.lines = nil       // Invalidate line cache, wrapping changes line breaks
+	t.hScrollOffset = 0 // Horizontal scrolling only applies to non-wrapped text
 	t.MarkDirty()
 }
 
@@ -116,9 +211,251 @@ func (t *Text) SetAlignment(align AlignmentText) {
 	}
 }
 
-// Focusable returns false, as Text components are not typically interactive or focusable.
+// defaultTabWidth is the tab stop distance used by new Text components.
+const defaultTabWidth = 4
+
+// SetTabWidth sets the distance between tab stops: each '\t' in the content is replaced by spaces
+// up to the next multiple of width (visual columns, so wide runes count as two).
+// A width of 0 leaves tab characters unexpanded. Search matches refer to the expanded text.
+func (t *Text) SetTabWidth(width int) {
+	width = max(width, 0)
+	if t.tabWidth == width {
+		return
+	}
+	t.tabWidth = width
+	t.lines = nil // Line contents change
+	if t.searchPattern != nil {
+		t.findMatches()
+	}
+	t.MarkDirty()
+}
+
+// SetMarkup enables or disables inline style tags in the content, e.g., "[red]error[-] in [bold]main.go".
+// A tag names a color ("red", "#ff8800"; see tcell.GetColor), an attribute ("bold", "dim", "italic",
+// "underline", "reverse", "blink", "strike") or a theme style ("highlight", "selected"), and applies
+// to the following text on top of the text style. Tags combine until "[-]" resets them; styles
+// also reset at the end of each line, so an unclosed tag extends to the end of its line. "[[" is a
+// literal "[", and brackets that don't form a known tag are shown as-is. Search matches and
+// sizes refer to the text without tags; GetContent returns the content with its tags.
+func (t *Text) SetMarkup(enabled bool) {
+	if t.markup == enabled {
+		return
+	}
+	t.markup = enabled
+	t.lines = nil // Line contents change
+	if t.searchPattern != nil {
+		t.findMatches()
+	}
+	t.MarkDirty()
+}
+
+// contentLines splits the content at newlines, removes markup tags (if enabled) and expands tabs
+// to the configured tab stops.
+func (t *Text) contentLines() []string {
+	lines, _ := t.parseContent()
+	return lines
+}
+
+// parseContent returns the content lines as described by contentLines, along with the styled
+// runs of those lines when markup is enabled.
+func (t *Text) parseContent() ([]string, []markupSpan) {
+	lines := strings.Split(t.content, "\n")
+	if t.markup {
+		var spans []markupSpan
+		for i, line := range lines {
+			plain, lineSpans := parseMarkupLine(line, t.tabWidth)
+			lines[i] = plain
+			for _, span := range lineSpans {
+				span.line = i
+				spans = append(spans, span)
+			}
+		}
+		return lines, spans
+	}
+	if t.tabWidth > 0 {
+		for i, line := range lines {
+			lines[i] = expandTabs(line, t.tabWidth)
+		}
+	}
+	return lines, nil
+}
+
+// parseMarkupLine removes the style tags from a single line, expanding tabs as it goes (so runs
+// refer to the expanded text), and returns the plain line with its styled runs.
+func parseMarkupLine(line string, tabWidth int) (string, []markupSpan) {
+	var (
+		b      strings.Builder
+		spans  []markupSpan
+		style  = DefaultStyle // Combined style of the open tags
+		styled bool           // Is any tag open?
+		start  int            // Start of the current run in b
+		column int
+	)
+	endRun := func() {
+		if styled && b.Len() > start {
+			spans = append(spans, markupSpan{start: start, end: b.Len(), style: style})
+		}
+		start = b.Len()
+	}
+
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		if r == '[' {
+			if strings.HasPrefix(line[i:], "[[") { // Escaped bracket
+				b.WriteByte('[')
+				column++
+				i += 2
+				continue
+			}
+			if end := strings.IndexByte(line[i+1:], ']'); end >= 0 {
+				name := line[i+1 : i+1+end]
+				if name == "-" {
+					endRun()
+					style, styled = DefaultStyle, false
+					i += end + 2
+					continue
+				}
+				if tagStyle, ok := markupTagStyle(name); ok {
+					endRun()
+					style, styled = style.MergeWith(tagStyle), true
+					i += end + 2
+					continue
+				}
+			}
+		}
+
+		if r == '\t' && tabWidth > 0 {
+			spaces := tabWidth - column%tabWidth
+			b.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+		} else {
+			b.WriteRune(r)
+			column += runewidth.RuneWidth(r)
+		}
+		i += size
+	}
+	endRun() // Unclosed tags extend to the end of the line
+	return b.String(), spans
+}
+
+// markupTagStyle returns the style a markup tag name stands for, or false if it isn't a known tag.
+func markupTagStyle(name string) (Style, bool) {
+	switch strings.ToLower(name) {
+	case "bold":
+		return DefaultStyle.Bold(true), true
+	case "dim":
+		return DefaultStyle.Dim(true), true
+	case "italic":
+		return DefaultStyle.Italic(true), true
+	case "underline":
+		return DefaultStyle.Underline(true), true
+	case "reverse":
+		return DefaultStyle.Reverse(true), true
+	case "blink":
+		return DefaultStyle.Blink(true), true
+	case "strike":
+		return DefaultStyle.StrikeThrough(true), true
+	case "highlight", "selected":
+		theme := GetTheme()
+		if theme == nil {
+			theme = NewDefaultTheme()
+		} // Fallback
+		if strings.EqualFold(name, "highlight") {
+			return theme.HighlightStyle(), true
+		}
+		return theme.TextSelectedStyle(), true
+	}
+	if color := tcell.GetColor(name); color != ColorDefault {
+		return DefaultStyle.Foreground(color), true
+	}
+	return DefaultStyle, false
+}
+
+// expandTabs replaces each tab in line with spaces up to the next multiple of tabWidth columns.
+func expandTabs(line string, tabWidth int) string {
+	if !strings.ContainsRune(line, '\t') {
+		return line
+	}
+	var b strings.Builder
+	column := 0
+	for _, r := range line {
+		if r == '\t' {
+			spaces := tabWidth - column%tabWidth
+			b.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+			continue
+		}
+		b.WriteRune(r)
+		column += runewidth.RuneWidth(r)
+	}
+	return b.String()
+}
+
+// SetVertical enables or disables vertical text: the runes of each line are stacked top-to-bottom
+// in a single column (two columns for lines containing wide runes), and successive lines form
+// successive columns from left to right, e.g., for side tab labels. While vertical, the alignment
+// places text at the top (AlignTextLeft), middle (AlignTextCenter) or bottom (AlignTextRight),
+// and wrapping and scrolling do not apply.
+func (t *Text) SetVertical(vertical bool) {
+	if t.vertical != vertical {
+		t.vertical = vertical
+		t.MarkDirty()
+	}
+}
+
+// PreferredSize returns the size that shows every line unwrapped: the widest line by the number
+// of lines (swapped for vertical text, ignoring wide runes). Implements Measurable.
+func (t *Text) PreferredSize() (width, height int) {
+	lines := t.contentLines()
+	for _, line := range lines {
+		if t.vertical {
+			width = max(width, len([]rune(line)))
+		} else {
+			width = max(width, runewidth.StringWidth(line))
+		}
+	}
+	if t.vertical {
+		return len(lines), width
+	}
+	return width, len(lines)
+}
+
+// SetFocusable controls whether the text can take focus. A focused Text scrolls with Up/Down,
+// PgUp/PgDn and Home/End (through its action map) and shows a scroll indicator on its right edge.
+func (t *Text) SetFocusable(focusable bool) {
+	if t.focusable == focusable {
+		return
+	}
+	t.focusable = focusable
+	t.MarkDirty()
+	if t.app == nil {
+		return
+	}
+	if !focusable && t.IsFocused() {
+		t.app.Dispatch(&FindNextFocusCommand{origin: t}) // Text is no longer focusable
+	}
+	if t.app.GetLayout() != nil {
+		t.app.Dispatch(&RecalculateNavIndicesCommand{}) // Focusability affects navigation indices
+	}
+}
+
+// Focusable returns true if SetFocusable(true) was called and the text is visible.
+// Text is not focusable by default.
 func (t *Text) Focusable() bool {
-	return false
+	return t.focusable && t.IsVisible()
+}
+
+// KeyHints returns the keys the text responds to while focused.
+func (t *Text) KeyHints() []KeyHint {
+	hints := []KeyHint{
+		{Key: "↑/↓", Description: "scroll"},
+		{Key: "PgUp/PgDn", Description: "page"},
+		{Key: "Home/End", Description: "top/bottom"},
+	}
+	if !t.wrap {
+		hints = append(hints, KeyHint{Key: "←/→", Description: "scroll sideways"})
+	}
+	return hints
 }
 
 // Draw renders the text component onto the screen, handling wrapping, scrolling, and alignment.
@@ -139,6 +476,11 @@ func (t *Text) Draw(screen tcell.Screen) {
 	// Clear the component area with the text style's background
 	Fill(screen, x, y, width, height, ' ', t.style)
 
+	if t.vertical {
+		t.drawVertical(screen, x, y, width, height)
+		return
+	}
+
 	// Get the slice of lines actually visible based on scroll offset and height
 	visibleLines := t.getVisibleLines(height)
 
@@ -146,6 +488,11 @@ func (t *Text) Draw(screen tcell.Screen) {
 	for i, line := range visibleLines {
 		lineScreenY := y + i // Calculate screen Y coordinate for this line
 
+		// Apply horizontal scrolling (non-wrapping text only)
+		if !t.wrap && t.hScrollOffset > 0 {
+			line = sliceFromColumn(line, t.hScrollOffset)
+		}
+
 		// Truncate line if it's somehow wider than the component width (safeguard)
 		// runewidth.Truncate handles wide chars correctly.
 		displayLine := runewidth.Truncate(line, width, "…") // Use ellipsis for truncation
@@ -168,18 +515,83 @@ func (t *Text) Draw(screen tcell.Screen) {
 
 		// Draw the text for this line at the calculated position
 		DrawText(screen, lineScreenX, lineScreenY, t.style, displayLine)
+		if len(t.markupSpans) > 0 {
+			t.drawMarkup(screen, t.scrollOffset+i, lineScreenX, lineScreenY, lineWidth)
+		}
+		if len(t.matches) > 0 {
+			t.drawMatches(screen, t.scrollOffset+i, lineScreenX, lineScreenY, lineWidth)
+		}
+	}
+
+	if t.IsFocused() {
+		t.drawScrollIndicator(screen, x, y, width, height)
 	}
 }
 
+// drawScrollIndicator draws a scrollbar over the right-most column as the focus cue: the thumb
+// shows the visible part of the text and fills the track when everything fits.
+func (t *Text) drawScrollIndicator(screen tcell.Screen, x, y, width, height int) {
+	total := max(len(t.lines), height)
+	thumbSize := max(height*height/total, 1)
+	thumbPos := 0
+	if maxOffset := total - height; maxOffset > 0 {
+		thumbPos = (height - thumbSize) * min(t.scrollOffset, maxOffset) / maxOffset
+	}
+	barX := x + width - 1
+	Fill(screen, barX, y, 1, height, RuneVLine, t.scrollBarStyle)
+	Fill(screen, barX, y+thumbPos, 1, thumbSize, '█', t.scrollBarThumbStyle)
+}
+
+// drawVertical renders each content line as a column of stacked runes, left to right.
+// Columns that would overflow the height end with an ellipsis.
+func (t *Text) drawVertical(screen tcell.Screen, x, y, width, height int) {
+	tcellStyle := t.style.ToTcell()
+	columnX := x
+	for _, line := range t.contentLines() {
+		runes := []rune(line)
+
+		// A column is as wide as its widest rune (wide runes reserve two cells)
+		columnWidth := 1
+		for _, r := range runes {
+			columnWidth = max(columnWidth, runewidth.RuneWidth(r))
+		}
+		if columnX+columnWidth > x+width {
+			break // No room for another column
+		}
+
+		if len(runes) > height {
+			runes = append(runes[:height-1], '…')
+		}
+		startY := y
+		switch t.alignment {
+		case AlignTextCenter:
+			startY = y + (height-len(runes))/2
+		case AlignTextRight:
+			startY = y + height - len(runes)
+		}
+
+		for i, r := range runes {
+			screen.SetContent(columnX, startY+i, r, nil, tcellStyle)
+		}
+		columnX += columnWidth
+	}
+}
+
+// SetRect sets the component's position and size.
+// A width change invalidates the line cache, since wrapping depends on the width.
+func (t *Text) SetRect(x, y, width, height int) {
+	if width != t.rect.Width {
+		t.lines = nil // Recalculate wrapped lines for the new width
+	}
+	t.BaseComponent.SetRect(x, y, width, height)
+}
+
 // ensureLinesCalculated makes sure the t.lines cache is populated.
-// Calls calculateLines only if the cache is nil (invalidated).
+// Calls calculateLines only if the cache is nil (invalidated by content, wrap or width changes).
 func (t *Text) ensureLinesCalculated(currentWidth int) {
 	if t.lines == nil {
 		t.calculateLines(currentWidth)
 	}
-	// TODO: Also recalculate if currentWidth != previously_calculated_width?
-	// This requires storing the width used for the last calculation.
-	// For now, rely on SetRect invalidating or content changes invalidating.
 }
 
 // calculateLines processes the raw content into display lines based on wrapping and width.
@@ -187,93 +599,116 @@ func (t *Text) ensureLinesCalculated(currentWidth int) {
 func (t *Text) calculateLines(maxWidth int) {
 	if maxWidth <= 0 {
 		t.lines = []string{} // No space, no lines
+		t.lineOrigins = nil
 		return
 	}
 
 	// Split content by explicit newline characters first.
-	rawLines := strings.Split(t.content, "\n")
+	rawLines, spans := t.parseContent()
+	t.markupSpans = spans
 	processedLines := make([]string, 0, len(rawLines)) // Estimate capacity
 
+	origins := make([]lineOrigin, 0, len(rawLines))
+
 	if !t.wrap {
 		// No wrapping enabled, just use the raw lines directly.
 		// Truncation will happen during Draw if lines exceed maxWidth.
 		processedLines = rawLines
+		for i := range rawLines {
+			origins = append(origins, lineOrigin{line: i})
+		}
 	} else {
 		// Word wrapping logic
-		for _, line := range rawLines {
+		for rawIndex, line := range rawLines {
 			// Handle empty lines resulting from consecutive newlines
 			if line == "" {
 				processedLines = append(processedLines, "")
+				origins = append(origins, lineOrigin{line: rawIndex})
 				continue
 			}
 
-			// Use rune-aware processing for wrapping
-			lineRunes := []rune(line)
-			startIndex := 0 // Start index of the current segment being processed
-			for startIndex < len(lineRunes) {
-				endIndex := startIndex
-				currentLineWidth := 0
-				lastPotentialBreak := startIndex // Index after the last space found
-
-				// Find the maximum number of runes that fit within maxWidth
-				for endIndex < len(lineRunes) {
-					r := lineRunes[endIndex]
-					rWidth := runewidth.RuneWidth(r)
-
-					if currentLineWidth+rWidth > maxWidth {
-						break // This rune doesn't fit
-					}
-					currentLineWidth += rWidth
+			byteOffset := 0 // Byte offset of the current segment within the raw line
+			for _, segment := range wrapLine(line, maxWidth) {
+				processedLines = append(processedLines, segment)
+				origins = append(origins, lineOrigin{line: rawIndex, offset: byteOffset})
+				byteOffset += len(segment)
+			}
+		}
+	}
 
-					// Track last space for potential word break
-					if r == ' ' {
-						lastPotentialBreak = endIndex + 1
-					}
-					endIndex++
-				}
+	t.lines = processedLines // Cache the result
+	t.lineOrigins = origins
+}
 
-				// Determine the actual break point
-				breakIndex := endIndex
-				if endIndex < len(lineRunes) { // If we didn't reach the end of the line...
-					// ...and we found a space to break at within the fitted segment...
-					if lastPotentialBreak > startIndex {
-						breakIndex = lastPotentialBreak // Break at the space
-					} else {
-						// No space found, and the segment exceeds width.
-						// Force break at endIndex (middle of a word).
-						// Ensure at least one character is included if first char is too wide.
-						if breakIndex == startIndex && currentLineWidth == 0 && endIndex < len(lineRunes) {
-							breakIndex = startIndex + 1
-						} else if breakIndex == startIndex {
-							// If the first word itself is too long, breakIndex remains endIndex
-							// Example: "Superlongwordthatdoesntfit"
-							// breakIndex should allow the Truncate in Draw to handle it?
-							// Or should we truncate here? Let's break forcefully.
-							if startIndex == 0 && runewidth.StringWidth(string(lineRunes[startIndex:endIndex])) > maxWidth {
-								// Force break after maxWidth runes approx. Difficult with variable width.
-								// Let Draw handle truncation in this edge case for simplicity.
-								// For calculation here, take what fits.
-								breakIndex = endIndex // Take the part that fits
-							}
-						}
-					}
-				}
+// wrapLine word-wraps a single line (without newlines) into segments that fit within maxWidth cells,
+// breaking after spaces where possible and mid-word otherwise. Trailing spaces are kept on each
+// segment, so the segments concatenate back to the original line.
+func wrapLine(line string, maxWidth int) []string {
+	var segments []string
+	// Use rune-aware processing for wrapping
+	lineRunes := []rune(line)
+	startIndex := 0 // Start index of the current segment being processed
+	for startIndex < len(lineRunes) {
+		endIndex := startIndex
+		currentLineWidth := 0
+		lastPotentialBreak := startIndex // Index after the last space found
+
+		// Find the maximum number of runes that fit within maxWidth
+		for endIndex < len(lineRunes) {
+			r := lineRunes[endIndex]
+			rWidth := runewidth.RuneWidth(r)
+
+			if currentLineWidth+rWidth > maxWidth {
+				break // This rune doesn't fit
+			}
+			currentLineWidth += rWidth
 
-				// Add the segment to processed lines, trimming trailing space if broken at space
-				segment := lineRunes[startIndex:breakIndex]
-				// Trim trailing space only if we broke at a space (lastPotentialBreak == breakIndex)
-				// if lastPotentialBreak == breakIndex && len(segment) > 0 && segment[len(segment)-1] == ' ' {
-				//      segment = segment[:len(segment)-1]
-				// }
-				// Simpler: let's not trim here, Draw handles final display width.
+			// Track last space for potential word break
+			if r == ' ' {
+				lastPotentialBreak = endIndex + 1
+			}
+			endIndex++
+		}
 
-				processedLines = append(processedLines, string(segment))
-				startIndex = breakIndex // Start next segment after the break
+		// Determine the actual break point
+		breakIndex := endIndex
+		if endIndex < len(lineRunes) { // If we didn't reach the end of the line...
+			// ...and we found a space to break at within the fitted segment...
+			if lastPotentialBreak > startIndex {
+				breakIndex = lastPotentialBreak // Break at the space
+			} else {
+				// No space found, and the segment exceeds width.
+				// Force break at endIndex (middle of a word).
+				// Ensure at least one character is included if first char is too wide.
+				if breakIndex == startIndex && currentLineWidth == 0 && endIndex < len(lineRunes) {
+					breakIndex = startIndex + 1
+				} else if breakIndex == startIndex {
+					// If the first word itself is too long, breakIndex remains endIndex
+					// Example: "Superlongwordthatdoesntfit"
+					// breakIndex should allow the Truncate in Draw to handle it?
+					// Or should we truncate here? Let's break forcefully.
+					if startIndex == 0 && runewidth.StringWidth(string(lineRunes[startIndex:endIndex])) > maxWidth {
+						// Force break after maxWidth runes approx. Difficult with variable width.
+						// Let Draw handle truncation in this edge case for simplicity.
+						// For calculation here, take what fits.
+						breakIndex = endIndex // Take the part that fits
+					}
+				}
 			}
 		}
-	}
 
-	t.lines = processedLines // Cache the result
+		// Add the segment to processed lines, trimming trailing space if broken at space
+		segment := lineRunes[startIndex:breakIndex]
+		// Trim trailing space only if we broke at a space (lastPotentialBreak == breakIndex)
+		// if lastPotentialBreak == breakIndex && len(segment) > 0 && segment[len(segment)-1] == ' ' {
+		//      segment = segment[:len(segment)-1]
+		// }
+		// Simpler: let's not trim here, Draw handles final display width.
+
+		segments = append(segments, string(segment))
+		startIndex = breakIndex // Start next segment after the break
+	}
+	return segments
 }
 
 // getVisibleLines returns the slice of processed lines that should be visible
@@ -316,31 +751,81 @@ func (t *Text) getVisibleLines(maxHeight int) []string {
 	return t.lines[startLine:endLine]
 }
 
-// HandleEvent processes events. Text components typically don't handle events by default.
-// Scrolling could potentially be added here if the component were made focusable.
+// HandleEvent scrolls on mouse wheel events (see Application.SetMouseEnabled) and, while focused,
+// scrolls with the up/down, page and line start/end actions, and scrolls non-wrapped text
+// horizontally with the left/right actions.
 func (t *Text) HandleEvent(event tcell.Event) bool {
-	// Example: Make Text scrollable if focusable
-	// if t.Focusable() && t.IsFocused() {
-	// 	if keyEvent, ok := event.(*tcell.EventKey); ok {
-	// 		switch keyEvent.Key() {
-	// 		case tcell.KeyDown:
-	// 			t.ScrollDown(1)
-	// 			return true
-	// 		case tcell.KeyUp:
-	// 			t.ScrollUp(1)
-	// 			return true
-	// 		case tcell.KeyPgDn:
-	// 			_, _, _, h := t.GetRect()
-	// 			t.ScrollDown(max(1, h)) // Scroll approx one page
-	// 			return true
-	// 		case tcell.KeyPgUp:
-	// 			_, _, _, h := t.GetRect()
-	// 			t.ScrollUp(max(1, h)) // Scroll approx one page
-	// 			return true
-	// 		}
-	// 	}
-	// }
-	return false // Event not handled
+	// Mouse wheel scrolling, delivered by the application whether or not the text is focused
+	if mouseEvent, ok := event.(*tcell.EventMouse); ok {
+		return t.handleWheel(mouseEvent)
+	}
+
+	keyEvent, ok := event.(*tcell.EventKey)
+	if !ok || !t.IsFocused() {
+		return false // Event not handled
+	}
+
+	page := max(t.rect.Height-1, 1) // Keep one line of context when paging
+	switch t.resolveAction(keyEvent) {
+	case ActionUp:
+		t.ScrollUp(1)
+	case ActionDown:
+		t.scrollDownClamped(1)
+	case ActionPageUp:
+		t.ScrollUp(page)
+	case ActionPageDown:
+		t.scrollDownClamped(page)
+	case ActionLineStart:
+		t.ScrollTo(0)
+	case ActionLineEnd:
+		t.ensureLinesCalculated(t.rect.Width)
+		t.scrollDownClamped(len(t.lines))
+	case ActionLeft: // Horizontal scrolling of non-wrapped text
+		if t.wrap {
+			return false
+		}
+		t.ScrollLeft(1)
+	case ActionRight:
+		if t.wrap {
+			return false
+		}
+		t.ScrollRight(1)
+	default:
+		return false
+	}
+	return true
+}
+
+// scrollDownClamped scrolls down by count lines, stopping once the last line is at the bottom,
+// so text that fits entirely doesn't move.
+func (t *Text) scrollDownClamped(count int) {
+	t.ensureLinesCalculated(t.rect.Width)
+	maxOffset := max(len(t.lines)-t.rect.Height, 0)
+	if t.scrollOffset < maxOffset {
+		t.ScrollTo(min(t.scrollOffset+count, maxOffset))
+	}
+}
+
+// textWheelLines is the number of lines (or columns) one mouse wheel step scrolls.
+const textWheelLines = 3
+
+// handleWheel scrolls on mouse wheel events. Scrolling down stops once the last line is at the
+// bottom, so text that fits entirely doesn't move. Returns true if the event was a wheel event.
+func (t *Text) handleWheel(ev *tcell.EventMouse) bool {
+	buttons := ev.Buttons()
+	switch {
+	case buttons&tcell.WheelUp != 0:
+		t.ScrollUp(textWheelLines)
+	case buttons&tcell.WheelDown != 0:
+		t.scrollDownClamped(textWheelLines)
+	case buttons&tcell.WheelLeft != 0:
+		t.ScrollLeft(textWheelLines)
+	case buttons&tcell.WheelRight != 0:
+		t.ScrollRight(textWheelLines)
+	default:
+		return false
+	}
+	return true
 }
 
 // ScrollTo attempts to scroll the text so that the specified line index is at the top.
@@ -386,4 +871,311 @@ func (t *Text) ScrollUp(count int) {
 		return
 	}
 	t.ScrollTo(t.scrollOffset - count)
+}
+
+// ScrollLeft scrolls non-wrapped text left by the specified number of columns.
+// Does nothing if count <= 0 or wrapping is enabled.
+func (t *Text) ScrollLeft(count int) {
+	if count <= 0 {
+		return
+	}
+	t.ScrollToColumn(t.hScrollOffset - count)
+}
+
+// ScrollRight scrolls non-wrapped text right by the specified number of columns.
+// Does nothing if count <= 0 or wrapping is enabled.
+func (t *Text) ScrollRight(count int) {
+	if count <= 0 {
+		return
+	}
+	t.ScrollToColumn(t.hScrollOffset + count)
+}
+
+// ScrollToColumn sets the first visible visual column of non-wrapped text.
+// Clamps so the longest line's end can be scrolled to the right edge but no further.
+func (t *Text) ScrollToColumn(column int) {
+	if t.wrap {
+		return // Wrapped lines always fit the width
+	}
+	t.ensureLinesCalculated(t.rect.Width)
+
+	longest := 0
+	for _, line := range t.lines {
+		longest = max(longest, runewidth.StringWidth(line))
+	}
+	maxOffset := max(longest-t.rect.Width, 0)
+	column = min(max(column, 0), maxOffset)
+
+	if t.hScrollOffset != column {
+		t.hScrollOffset = column
+		t.MarkDirty()
+	}
+}
+
+// sliceFromColumn returns the part of the line starting at the given visual column.
+// A wide rune cut in half by the offset is replaced by spaces to keep columns aligned.
+func sliceFromColumn(line string, column int) string {
+	pos := 0
+	for i, r := range line {
+		if pos >= column {
+			return line[i:]
+		}
+		w := runewidth.RuneWidth(r)
+		if pos+w > column { // Rune straddles the offset
+			return strings.Repeat(" ", pos+w-column) + line[i+len(string(r)):]
+		}
+		pos += w
+	}
+	return ""
+}
+
+// Search finds all case-insensitive occurrences of query, highlights them, and returns the
+// number of matches. The search stays active (and follows content changes) until ClearSearch.
+// An empty query clears the search. Use NextMatch/PrevMatch to scroll to each match.
+func (t *Text) Search(query string) int {
+	if query == "" {
+		t.ClearSearch()
+		return 0
+	}
+	return t.SearchRegexp(literalPattern(query, true))
+}
+
+// SearchCaseSensitive is like Search but only matches query with the exact same case.
+func (t *Text) SearchCaseSensitive(query string) int {
+	if query == "" {
+		t.ClearSearch()
+		return 0
+	}
+	return t.SearchRegexp(literalPattern(query, false))
+}
+
+// HighlightMatches searches for substr like Search (or SearchCaseSensitive if ignoreCase is false),
+// drawing matches with style instead of the theme's highlight style, and returns the number of
+// matches. Pass DefaultStyle to use the theme's highlight style again. An empty substr clears
+// the search.
+func (t *Text) HighlightMatches(substr string, style Style, ignoreCase bool) int {
+	t.highlightCustom = style != DefaultStyle
+	if !t.highlightCustom {
+		theme := GetTheme()
+		if theme == nil {
+			theme = NewDefaultTheme()
+		} // Fallback
+		style = theme.HighlightStyle()
+	}
+	t.highlightStyle = style
+	if substr == "" {
+		t.ClearSearch()
+		return 0
+	}
+	return t.SearchRegexp(literalPattern(substr, ignoreCase))
+}
+
+// Find returns the indices of the content lines (split at newlines, counting from 0) that contain
+// substr, matching case-insensitively if ignoreCase is set. It doesn't change the active search.
+func (t *Text) Find(substr string, ignoreCase bool) []int {
+	if substr == "" {
+		return nil
+	}
+	re := literalPattern(substr, ignoreCase)
+	var found []int
+	for i, line := range t.contentLines() {
+		if re.MatchString(line) {
+			found = append(found, i)
+		}
+	}
+	return found
+}
+
+// literalPattern returns a pattern matching query literally, ignoring case if requested.
+func literalPattern(query string, ignoreCase bool) *regexp.Regexp {
+	if ignoreCase {
+		return regexp.MustCompile("(?i)" + regexp.QuoteMeta(query))
+	}
+	return regexp.MustCompile(regexp.QuoteMeta(query))
+}
+
+// SearchRegexp highlights all matches of re within each line and returns the number of matches.
+// Empty matches are ignored. A nil re clears the search.
+func (t *Text) SearchRegexp(re *regexp.Regexp) int {
+	if re == nil {
+		t.ClearSearch()
+		return 0
+	}
+	t.searchPattern = re
+	t.currentMatch = -1
+	t.findMatches()
+	t.MarkDirty()
+	return len(t.matches)
+}
+
+// ClearSearch removes the active search and its highlights.
+func (t *Text) ClearSearch() {
+	if t.searchPattern == nil && len(t.matches) == 0 {
+		return
+	}
+	t.searchPattern = nil
+	t.matches = nil
+	t.currentMatch = -1
+	t.MarkDirty()
+}
+
+// MatchCount returns the number of matches of the active search.
+func (t *Text) MatchCount() int {
+	return len(t.matches)
+}
+
+// CurrentMatch returns the index of the match selected by NextMatch/PrevMatch, or -1 if none.
+func (t *Text) CurrentMatch() int {
+	return t.currentMatch
+}
+
+// findMatches records every non-empty match of the search pattern in the raw content lines.
+func (t *Text) findMatches() {
+	t.matches = t.matches[:0]
+	for i, line := range t.contentLines() {
+		for _, loc := range t.searchPattern.FindAllStringIndex(line, -1) {
+			if loc[1] > loc[0] {
+				t.matches = append(t.matches, textMatch{line: i, start: loc[0], end: loc[1]})
+			}
+		}
+	}
+	if t.currentMatch >= len(t.matches) {
+		t.currentMatch = -1
+	}
+}
+
+// NextMatch selects the next match of the active search (wrapping around) and scrolls it into view.
+// Returns false if there are no matches.
+func (t *Text) NextMatch() bool {
+	return t.stepMatch(1)
+}
+
+// PrevMatch selects the previous match of the active search (wrapping around) and scrolls it into view.
+// Returns false if there are no matches.
+func (t *Text) PrevMatch() bool {
+	return t.stepMatch(-1)
+}
+
+// ScrollToMatch selects the nth match (counting from 0) of the active search and scrolls it into
+// view. Returns false if there is no such match.
+func (t *Text) ScrollToMatch(n int) bool {
+	if n < 0 || n >= len(t.matches) {
+		return false
+	}
+	t.currentMatch = n
+	t.scrollToMatch(t.matches[n])
+	t.MarkDirty()
+	return true
+}
+
+// stepMatch implements NextMatch/PrevMatch.
+func (t *Text) stepMatch(direction int) bool {
+	if len(t.matches) == 0 {
+		return false
+	}
+	if t.currentMatch < 0 && direction < 0 {
+		return t.ScrollToMatch(len(t.matches) - 1)
+	}
+	return t.ScrollToMatch((t.currentMatch + direction + len(t.matches)) % len(t.matches))
+}
+
+// scrollToMatch scrolls so the display line holding the start of m is visible (centered if it
+// was off-screen) and, for non-wrapped text, so the match is within the visible columns.
+func (t *Text) scrollToMatch(m textMatch) {
+	if t.rect.Width <= 0 || t.rect.Height <= 0 {
+		return // Not laid out yet
+	}
+	t.ensureLinesCalculated(t.rect.Width)
+
+	displayLine := -1
+	for i, origin := range t.lineOrigins {
+		if origin.line == m.line && origin.offset <= m.start {
+			displayLine = i // Last segment starting at or before the match
+		} else if origin.line > m.line {
+			break
+		}
+	}
+	if displayLine < 0 {
+		return
+	}
+	if displayLine < t.scrollOffset || displayLine >= t.scrollOffset+t.rect.Height {
+		t.ScrollTo(max(displayLine-t.rect.Height/2, 0))
+	}
+
+	if !t.wrap {
+		line := t.lines[displayLine]
+		startCol := runewidth.StringWidth(line[:m.start])
+		endCol := runewidth.StringWidth(line[:m.end])
+		if startCol < t.hScrollOffset {
+			t.ScrollToColumn(startCol)
+		} else if endCol > t.hScrollOffset+t.rect.Width {
+			t.ScrollToColumn(endCol - t.rect.Width)
+		}
+	}
+}
+
+// drawMatches restyles the matched runes of the given display line, which was drawn at
+// (screenX, screenY) occupying drawnWidth cells.
+func (t *Text) drawMatches(screen tcell.Screen, displayLine, screenX, screenY, drawnWidth int) {
+	if displayLine < 0 || displayLine >= len(t.lines) || displayLine >= len(t.lineOrigins) {
+		return
+	}
+	origin := t.lineOrigins[displayLine]
+	line := t.lines[displayLine]
+	hScroll := 0
+	if !t.wrap {
+		hScroll = t.hScrollOffset
+	}
+
+	for i, m := range t.matches {
+		if m.line != origin.line || m.end <= origin.offset || m.start >= origin.offset+len(line) {
+			continue // Match is not on this display line
+		}
+		style := t.highlightStyle
+		if i == t.currentMatch {
+			style = t.currentMatchStyle
+		}
+		restyleRange(screen, line, origin.offset, hScroll, screenX, screenY, drawnWidth, m.start, m.end, style)
+	}
+}
+
+// drawMarkup restyles the markup runs that fall on a drawn display line, before search highlights.
+func (t *Text) drawMarkup(screen tcell.Screen, displayLine, screenX, screenY, drawnWidth int) {
+	if displayLine < 0 || displayLine >= len(t.lines) || displayLine >= len(t.lineOrigins) {
+		return
+	}
+	origin := t.lineOrigins[displayLine]
+	line := t.lines[displayLine]
+	hScroll := 0
+	if !t.wrap {
+		hScroll = t.hScrollOffset
+	}
+
+	for _, span := range t.markupSpans {
+		if span.line != origin.line || span.end <= origin.offset || span.start >= origin.offset+len(line) {
+			continue // Run is not on this display line
+		}
+		restyleRange(screen, line, origin.offset, hScroll, screenX, screenY, drawnWidth, span.start, span.end, t.style.MergeWith(span.style))
+	}
+}
+
+// restyleRange applies style to the drawn cells of the display line that fall within the byte range
+// [start, end) of its content line. offset is the display line's byte offset in the content line,
+// hScroll the number of columns scrolled off the left, and drawnWidth the number of cells drawn.
+func restyleRange(screen tcell.Screen, line string, offset, hScroll, screenX, screenY, drawnWidth, start, end int, style Style) {
+	tcellStyle := style.ToTcell()
+	col := 0
+	for byteIndex, r := range line {
+		pos := offset + byteIndex
+		cell := col - hScroll
+		col += runewidth.RuneWidth(r)
+		if pos < start || pos >= end || cell < 0 {
+			continue
+		}
+		if cell >= drawnWidth {
+			break
+		}
+		mainc, combc, _, _ := screen.GetContent(screenX+cell, screenY)
+		screen.SetContent(screenX+cell, screenY, mainc, combc, tcellStyle)
+	}
 }
\ No newline at end of file
@@ -0,0 +1,103 @@
+// text_test.go
+package tinytui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestExpandTabs(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		tabWidth int
+		want     string
+	}{
+		{"no tabs", "plain", 4, "plain"},
+		{"leading tab", "\tx", 4, "    x"},
+		{"tab after ascii", "a\tb", 4, "a   b"},
+		{"tab on a stop", "abcd\te", 4, "abcd    e"},
+		{"wider stops", "a\tb", 8, "a       b"},
+		{"spaces then tab", "ab  \tc", 4, "ab      c"},
+		{"tab then spaces", "a\t  b", 4, "a     b"},
+		{"consecutive tabs", "  \t\tx", 4, "        x"},
+		{"wide rune counts as two", "日\tx", 4, "日  x"},
+		{"wide runes fill a stop", "日本\tx", 4, "日本    x"},
+		{"wide rune after ascii", "a日\tx", 4, "a日 x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandTabs(tt.line, tt.tabWidth); got != tt.want {
+				t.Errorf("expandTabs(%q, %d) = %q, want %q", tt.line, tt.tabWidth, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextTabWidth(t *testing.T) {
+	tests := []struct {
+		tabWidth int
+		want     string
+	}{
+		{4, "a   b"},
+		{2, "a b"},
+		{0, "a\tb"},  // Raw tabs
+		{-3, "a\tb"}, // Clamped to 0
+	}
+	for _, tt := range tests {
+		text := NewText("a\tb")
+		text.SetTabWidth(tt.tabWidth)
+		if got := text.contentLines()[0]; got != tt.want {
+			t.Errorf("SetTabWidth(%d): line %q, want %q", tt.tabWidth, got, tt.want)
+		}
+	}
+}
+
+func TestTextTabWidthDraw(t *testing.T) {
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("simulation screen: %v", err)
+	}
+	t.Cleanup(screen.Fini)
+	screen.SetSize(20, 2)
+
+	text := NewText("日\tx\n\ty")
+	text.SetRect(0, 0, 20, 2)
+	text.Draw(screen)
+	for _, cell := range []struct {
+		x, y int
+		want rune
+	}{
+		{0, 0, '日'}, {4, 0, 'x'}, // The wide rune takes two columns before the tab
+		{4, 1, 'y'},
+	} {
+		if got, _, _, _ := screen.GetContent(cell.x, cell.y); got != cell.want {
+			t.Errorf("cell %d,%d is %q, want %q", cell.x, cell.y, got, cell.want)
+		}
+	}
+}
+
+func TestTextSearchOffsetsWithTabs(t *testing.T) {
+	text := NewText("a\tfoo\n日\tfoo")
+	tests := []struct {
+		tabWidth int
+		want     []textMatch
+	}{
+		{4, []textMatch{{0, 4, 7}, {1, 5, 8}}}, // "日" is 3 bytes and 2 columns wide, so the tab adds 2 spaces
+		{8, []textMatch{{0, 8, 11}, {1, 9, 12}}},
+		{0, []textMatch{{0, 2, 5}, {1, 4, 7}}}, // Offsets into the raw lines
+	}
+	text.SearchCaseSensitive("foo")
+	for _, tt := range tests {
+		text.SetTabWidth(tt.tabWidth) // Re-runs the active search
+		if len(text.matches) != len(tt.want) {
+			t.Fatalf("tab width %d: %d matches, want %d", tt.tabWidth, len(text.matches), len(tt.want))
+		}
+		for i, m := range text.matches {
+			if m != tt.want[i] {
+				t.Errorf("tab width %d: match %d is %+v, want %+v", tt.tabWidth, i, m, tt.want[i])
+			}
+		}
+	}
+}
\ No newline at end of file
@@ -2,6 +2,9 @@
 package tinytui
 
 import (
+	"slices"
+	"unicode"
+
 	"github.com/gdamore/tcell/v2"
 	"github.com/mattn/go-runewidth"
 )
@@ -17,12 +20,34 @@ type TextInput struct {
 	style        Style        // Base style for the input field when not focused.
 	focusedStyle Style        // Style when the input field has focus.
 	maxLength    int          // Maximum number of runes allowed (0 for no limit).
+	placeholder  string       // Dimmed hint shown while the buffer is empty ("" = none).
 	onChange     func(string) // Callback function triggered when text content changes.
 	onSubmit     func(string) // Callback function triggered when Enter key is pressed.
 	masked       bool         // Display mask characters instead of actual text?
 	maskRune     rune         // Rune to use for masking (e.g., '*').
+	inputMask    []rune       // Input format (e.g., "(###) ###-####"); nil if not set. See SetMask.
+	raw          []rune       // Characters entered into the mask's editable slots (input mask only).
+	rawCursor    int          // Cursor position as an index into raw (input mask only).
+	baseline     string       // Text recorded by SetBaseline, for IsModified.
+	pasting      bool         // Is a bracketed paste in progress?
+	pasted       []rune       // Runes received since the paste started, inserted when it ends.
+
+	historyEnabled bool     // Record submissions and recall them with Up/Down?
+	history        []string // Submitted values, oldest first.
+	historyLimit   int      // Maximum number of recorded submissions (0 for no limit).
+	historyIndex   int      // Index into history of the recalled value (-1 when not navigating).
+	historyDraft   string   // Text being edited before navigation started, restored past the newest entry.
 }
 
+// defaultHistoryLimit is the number of submissions a TextInput remembers unless SetHistoryLimit is called.
+const defaultHistoryLimit = 100
+
+// Input mask slot characters (see SetMask). Any other mask character is a literal separator.
+const (
+	maskDigit  = '#' // Accepts a digit
+	maskLetter = 'A' // Accepts a letter
+)
+
 // NewTextInput creates a new text input component.
 // Initializes styles from the current theme.
 func NewTextInput() *TextInput {
@@ -41,6 +66,8 @@ func NewTextInput() *TextInput {
 		maxLength:     0,                               // No limit by default
 		masked:        false,
 		maskRune:      '*',
+		historyLimit:  defaultHistoryLimit,
+		historyIndex:  -1,
 		// onChange, onSubmit are nil initially
 	}
 	t.ApplyTheme(theme) // Ensure initial theme application correctly sets styles
@@ -73,6 +100,11 @@ func (t *TextInput) ApplyTheme(theme Theme) {
 // SetText replaces the current text content with the given string.
 // Enforces maximum length and moves the cursor to the end.
 func (t *TextInput) SetText(text string) {
+	if t.inputMask != nil {
+		t.setMaskedText(text)
+		return
+	}
+
 	newBuffer := []rune(text)
 
 	// Enforce maxLength if set
@@ -119,6 +151,17 @@ func (t *TextInput) GetText() string {
 	return string(t.buffer)
 }
 
+// SetBaseline records the current text as the unmodified value. Implements FormField.
+func (t *TextInput) SetBaseline() {
+	t.baseline = t.GetText()
+}
+
+// IsModified reports whether the text differs from the value recorded by SetBaseline
+// (or from empty if no baseline was recorded). Implements FormField.
+func (t *TextInput) IsModified() bool {
+	return t.GetText() != t.baseline
+}
+
 // SetStyle explicitly sets the base (unfocused) style, overriding the theme.
 // Consider using themes for consistent styling.
 func (t *TextInput) SetStyle(style Style) {
@@ -192,6 +235,263 @@ func (t *TextInput) SetMasked(masked bool, maskRune rune) {
 	t.MarkDirty() // Appearance changes, needs redraw
 }
 
+// SetMask sets an input format that is applied as the user types. In the mask, '#' accepts a digit,
+// 'A' accepts a letter and any other character is a literal separator inserted automatically
+// (e.g., "(###) ###-####" for a phone number). GetText returns the formatted text and RawValue
+// only the entered characters. Cursor movement and deletion skip over literals.
+// An empty mask removes the format. The maximum length does not apply while a mask is set.
+func (t *TextInput) SetMask(mask string) {
+	if mask == "" {
+		if t.inputMask != nil {
+			t.inputMask = nil
+			t.raw = nil
+			t.MarkDirty()
+		}
+		return
+	}
+	current := string(t.buffer)
+	t.inputMask = []rune(mask)
+	t.raw = nil
+	t.setMaskedText(current) // Re-fit any existing text into the mask
+	t.MarkDirty()
+}
+
+// RawValue returns the characters entered into the input mask's editable slots, without literals.
+// Without a mask it returns the same as GetText.
+func (t *TextInput) RawValue() string {
+	if t.inputMask == nil {
+		return t.GetText()
+	}
+	return string(t.raw)
+}
+
+// maskSlots returns the editable slot characters of the input mask, in order.
+func (t *TextInput) maskSlots() []rune {
+	slots := make([]rune, 0, len(t.inputMask))
+	for _, m := range t.inputMask {
+		if m == maskDigit || m == maskLetter {
+			slots = append(slots, m)
+		}
+	}
+	return slots
+}
+
+// maskAccepts reports whether rune r may fill a slot of the given kind.
+func maskAccepts(slot, r rune) bool {
+	switch slot {
+	case maskDigit:
+		return unicode.IsDigit(r)
+	case maskLetter:
+		return unicode.IsLetter(r)
+	}
+	return false
+}
+
+// maskValid reports whether the raw runes fit the mask's slots in order.
+func (t *TextInput) maskValid(raw []rune) bool {
+	slots := t.maskSlots()
+	if len(raw) > len(slots) {
+		return false
+	}
+	for i, r := range raw {
+		if !maskAccepts(slots[i], r) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatMask renders raw runes through the mask. Literals are emitted only when more
+// entered characters follow them, so the text grows as the user types.
+func (t *TextInput) formatMask(raw []rune) []rune {
+	formatted := make([]rune, 0, len(t.inputMask))
+	rawIndex := 0
+	for _, m := range t.inputMask {
+		if rawIndex >= len(raw) {
+			break
+		}
+		if m == maskDigit || m == maskLetter {
+			formatted = append(formatted, raw[rawIndex])
+			rawIndex++
+		} else {
+			formatted = append(formatted, m)
+		}
+	}
+	return formatted
+}
+
+// maskCursorPos converts a raw index into a position in the formatted buffer:
+// the position of that editable slot, or the end of the text if the slot isn't shown yet.
+func (t *TextInput) maskCursorPos(rawIndex int) int {
+	slot := 0
+	for pos, m := range t.inputMask {
+		if m != maskDigit && m != maskLetter {
+			continue
+		}
+		if slot == rawIndex {
+			return min(pos, len(t.buffer))
+		}
+		slot++
+	}
+	return len(t.buffer)
+}
+
+// setMaskedText fits text into the mask: runes are taken in order where they match the
+// next slot and skipped otherwise (so both formatted and raw input are accepted).
+func (t *TextInput) setMaskedText(text string) {
+	slots := t.maskSlots()
+	raw := make([]rune, 0, len(slots))
+	for _, r := range text {
+		if len(raw) < len(slots) && maskAccepts(slots[len(raw)], r) {
+			raw = append(raw, r)
+		}
+	}
+	t.applyMaskedEdit(raw, len(raw))
+}
+
+// applyMaskedEdit stores new raw input and cursor, reformats the buffer and fires onChange if the text changed.
+func (t *TextInput) applyMaskedEdit(raw []rune, rawCursor int) {
+	textBefore := string(t.buffer)
+	t.raw = raw
+	t.rawCursor = min(max(rawCursor, 0), len(raw))
+	t.buffer = t.formatMask(raw)
+	t.cursorPos = t.maskCursorPos(t.rawCursor)
+	t.updateVisualOffset()
+	t.MarkDirty()
+
+	if newText := string(t.buffer); newText != textBefore && t.onChange != nil {
+		t.onChange(newText)
+	}
+}
+
+// handleMaskedKey processes editing and cursor keys while an input mask is set.
+// Returns false for keys it doesn't handle (e.g., Enter), leaving them to the normal path.
+func (t *TextInput) handleMaskedKey(keyEvent *tcell.EventKey) bool {
+	raw := append([]rune(nil), t.raw...) // Work on a copy; only apply valid edits
+	cursor := t.rawCursor
+
+	switch keyEvent.Key() {
+	case tcell.KeyRune:
+		raw = append(raw[:cursor], append([]rune{keyEvent.Rune()}, raw[cursor:]...)...)
+		cursor++
+	case tcell.KeyDelete:
+		if cursor >= len(raw) {
+			return true
+		}
+		raw = append(raw[:cursor], raw[cursor+1:]...)
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if cursor == 0 {
+			return true
+		}
+		raw = append(raw[:cursor-1], raw[cursor:]...) // Removes the entered character, never a literal
+		cursor--
+	case tcell.KeyLeft:
+		cursor--
+	case tcell.KeyRight:
+		cursor++
+	case tcell.KeyHome, tcell.KeyCtrlA:
+		cursor = 0
+	case tcell.KeyEnd, tcell.KeyCtrlE:
+		cursor = len(raw)
+	default:
+		return false
+	}
+
+	if !t.maskValid(raw) {
+		return true // Rejected: the character doesn't fit its slot (or the mask is full); consume the key
+	}
+	t.applyMaskedEdit(raw, cursor)
+	return true
+}
+
+// SetPlaceholder sets a hint (e.g., "Enter name") drawn dimmed while the input is empty. It is not
+// part of the text: GetText returns "" and the hint disappears as soon as a character is entered.
+// Pass "" to remove it.
+func (t *TextInput) SetPlaceholder(text string) {
+	if t.placeholder != text {
+		t.placeholder = text
+		t.MarkDirty()
+	}
+}
+
+// GetPlaceholder returns the placeholder text ("" if none).
+func (t *TextInput) GetPlaceholder() string {
+	return t.placeholder
+}
+
+// SetHistoryEnabled turns submission history on or off. When enabled, each non-empty value submitted
+// with Enter is recorded, and Up/Down replace the text with earlier/later submissions, like a shell
+// prompt. Moving Down past the newest entry restores the text being typed before recall started.
+// Disabling keeps the recorded history but stops recall.
+func (t *TextInput) SetHistoryEnabled(enabled bool) {
+	t.historyEnabled = enabled
+	t.historyIndex = -1
+}
+
+// SetHistoryLimit sets the maximum number of submissions kept in the history; the oldest entries
+// are dropped first. A limit of 0 or less removes the limit. The default is 100.
+func (t *TextInput) SetHistoryLimit(limit int) {
+	t.historyLimit = max(limit, 0)
+	t.trimHistory()
+}
+
+// GetHistory returns a copy of the recorded submissions, oldest first.
+func (t *TextInput) GetHistory() []string {
+	return slices.Clone(t.history)
+}
+
+// recordHistory appends a submitted value to the history, skipping empty values and
+// immediate repeats, and ends any history navigation.
+func (t *TextInput) recordHistory(text string) {
+	t.historyIndex = -1
+	if text == "" || (len(t.history) > 0 && t.history[len(t.history)-1] == text) {
+		return
+	}
+	t.history = append(t.history, text)
+	t.trimHistory()
+}
+
+// trimHistory drops the oldest entries beyond the history limit.
+func (t *TextInput) trimHistory() {
+	if t.historyLimit > 0 && len(t.history) > t.historyLimit {
+		t.history = slices.Clone(t.history[len(t.history)-t.historyLimit:])
+		t.historyIndex = -1
+	}
+}
+
+// recallHistory replaces the text with the previous (older) or next (newer) history entry and
+// moves the cursor to the end. Returns false if history is disabled, leaving the key unhandled.
+func (t *TextInput) recallHistory(older bool) bool {
+	if !t.historyEnabled {
+		return false
+	}
+	switch {
+	case older && len(t.history) == 0, older && t.historyIndex == 0, !older && t.historyIndex < 0:
+		return true // Nothing further in that direction
+	case older && t.historyIndex < 0: // Start navigating from the newest entry, keeping the draft
+		t.historyDraft = string(t.buffer)
+		t.historyIndex = len(t.history) - 1
+	case older:
+		t.historyIndex--
+	default:
+		t.historyIndex++
+	}
+
+	text := t.historyDraft
+	if t.historyIndex >= len(t.history) {
+		t.historyIndex = -1 // Past the newest entry: back to the draft
+	} else {
+		text = t.history[t.historyIndex]
+	}
+	t.SetText(text)
+	if t.inputMask == nil {
+		t.cursorPos = len(t.buffer) // SetText leaves the cursor alone when the text is unchanged
+		t.updateVisualOffset()
+		t.MarkDirty()
+	}
+	return true
+}
+
 // SetOnChange sets the callback function triggered whenever the text content changes due to user input.
 func (t *TextInput) SetOnChange(handler func(string)) {
 	t.onChange = handler
@@ -207,6 +507,80 @@ func (t *TextInput) Focusable() bool {
 	return t.IsVisible()
 }
 
+// KeyHints returns the keys the text input responds to while focused.
+func (t *TextInput) KeyHints() []KeyHint {
+	hints := []KeyHint{
+		{Key: "←/→", Description: "move cursor"},
+		{Key: "Ctrl+←/→", Description: "move by word"},
+		{Key: "Home/End", Description: "start/end"},
+		{Key: "Bksp/Del", Description: "delete"},
+		{Key: "Enter", Description: "submit"},
+	}
+	if t.historyEnabled {
+		hints = append(hints, KeyHint{Key: "↑/↓", Description: "history"})
+	}
+	return hints
+}
+
+// insertPasted inserts pasted runes at the cursor as one edit, so onChange fires once. Runes beyond
+// the maximum length are dropped; with an input mask, runes that don't fit their slot (such as
+// pasted separators) are skipped.
+func (t *TextInput) insertPasted(runes []rune) {
+	if t.inputMask != nil {
+		raw := append([]rune(nil), t.raw...)
+		cursor := t.rawCursor
+		for _, r := range runes {
+			next := slices.Concat(raw[:cursor], []rune{r}, raw[cursor:])
+			if t.maskValid(next) {
+				raw = next
+				cursor++
+			}
+		}
+		t.applyMaskedEdit(raw, cursor)
+		return
+	}
+
+	if t.maxLength > 0 {
+		runes = runes[:min(len(runes), max(t.maxLength-len(t.buffer), 0))]
+	}
+	if len(runes) == 0 {
+		return
+	}
+	t.buffer = slices.Concat(t.buffer[:t.cursorPos], runes, t.buffer[t.cursorPos:])
+	t.cursorPos += len(runes)
+	t.updateVisualOffset()
+	t.MarkDirty()
+	if t.onChange != nil {
+		t.onChange(string(t.buffer))
+	}
+}
+
+// prevWordStart returns the buffer index of the start of the word before the cursor, skipping any
+// whitespace run first (words are separated by whitespace). Returns 0 if there is no earlier word.
+func (t *TextInput) prevWordStart() int {
+	pos := t.cursorPos
+	for pos > 0 && unicode.IsSpace(t.buffer[pos-1]) {
+		pos--
+	}
+	for pos > 0 && !unicode.IsSpace(t.buffer[pos-1]) {
+		pos--
+	}
+	return pos
+}
+
+// nextWordStart returns the buffer index of the start of the word after the cursor: the rest of the
+// current word and the following whitespace run are skipped. Returns len(buffer) if there is none.
+func (t *TextInput) nextWordStart() int {
+	pos := t.cursorPos
+	for pos < len(t.buffer) && !unicode.IsSpace(t.buffer[pos]) {
+		pos++
+	}
+	for pos < len(t.buffer) && unicode.IsSpace(t.buffer[pos]) {
+		pos++
+	}
+	return pos
+}
+
 // Draw renders the text input component, including text (masked or not), and requests cursor position.
 func (t *TextInput) Draw(screen tcell.Screen) {
 	if !t.IsVisible() {
@@ -244,8 +618,12 @@ func (t *TextInput) Draw(screen tcell.Screen) {
 	visibleRunes := t.getVisibleRunes(displayRunes, width)
 	visibleText := string(visibleRunes)
 
-	// Draw the visible text onto the screen
-	DrawText(screen, x, y, currentStyle, visibleText)
+	// Draw the visible text onto the screen, or the dimmed placeholder while empty
+	if len(t.buffer) == 0 && t.placeholder != "" {
+		DrawText(screen, x, y, currentStyle.Dim(true), runewidth.Truncate(t.placeholder, width, "…"))
+	} else {
+		DrawText(screen, x, y, currentStyle, visibleText)
+	}
 
 	// If focused, calculate and request the cursor position
 	if t.IsFocused() {
@@ -380,11 +758,47 @@ func (t *TextInput) updateVisualOffset() {
 // HandleEvent processes key events for text input manipulation (insert, delete, backspace),
 // cursor movement (arrows, home, end), and submission (Enter).
 func (t *TextInput) HandleEvent(event tcell.Event) bool {
+	if pasteEvent, ok := event.(*tcell.EventPaste); ok {
+		if pasteEvent.Start() {
+			t.pasting, t.pasted = true, nil
+		} else {
+			t.pasting = false
+			t.historyIndex = -1
+			t.insertPasted(t.pasted)
+			t.pasted = nil
+		}
+		return true
+	}
+
 	keyEvent, ok := event.(*tcell.EventKey)
 	if !ok {
 		return false // Not a key event
 	}
 
+	// Collect pasted characters for a single insertion when the paste ends
+	if t.pasting {
+		switch keyEvent.Key() {
+		case tcell.KeyRune:
+			t.pasted = append(t.pasted, keyEvent.Rune())
+		case tcell.KeyEnter, tcell.KeyLF, tcell.KeyTab:
+			t.pasted = append(t.pasted, ' ') // Line breaks and tabs become spaces in a single-line input
+		}
+		return true
+	}
+
+	// History recall; any edit ends navigation, keeping the recalled text
+	switch keyEvent.Key() {
+	case tcell.KeyUp, tcell.KeyDown:
+		return t.recallHistory(keyEvent.Key() == tcell.KeyUp)
+	case tcell.KeyRune, tcell.KeyDelete, tcell.KeyBackspace, tcell.KeyBackspace2:
+		t.historyIndex = -1
+	}
+
+	// Input masks have their own editing rules
+	if t.inputMask != nil && t.handleMaskedKey(keyEvent) {
+		return true
+	}
+
 	textBefore := string(t.buffer) // Store state before modification for onChange check
 	contentChanged := false
 	cursorMoved := false
@@ -418,12 +832,22 @@ func (t *TextInput) HandleEvent(event tcell.Event) bool {
 
 	// --- Cursor Movement ---
 	case tcell.KeyLeft:
-		if t.cursorPos > 0 {
+		if keyEvent.Modifiers()&tcell.ModCtrl != 0 { // Ctrl+Left: start of the previous word
+			if pos := t.prevWordStart(); pos != t.cursorPos {
+				t.cursorPos = pos
+				cursorMoved = true
+			}
+		} else if t.cursorPos > 0 {
 			t.cursorPos--
 			cursorMoved = true
 		}
 	case tcell.KeyRight:
-		if t.cursorPos < len(t.buffer) {
+		if keyEvent.Modifiers()&tcell.ModCtrl != 0 { // Ctrl+Right: start of the next word
+			if pos := t.nextWordStart(); pos != t.cursorPos {
+				t.cursorPos = pos
+				cursorMoved = true
+			}
+		} else if t.cursorPos < len(t.buffer) {
 			t.cursorPos++
 			cursorMoved = true
 		}
@@ -437,11 +861,13 @@ func (t *TextInput) HandleEvent(event tcell.Event) bool {
 			t.cursorPos = len(t.buffer)
 			cursorMoved = true
 		}
-	// TODO: Add Ctrl+Left/Right for word navigation? Requires word boundary detection.
 	// TODO: Add Ctrl+U to delete line before cursor? Ctrl+K delete after?
 
 	// --- Submission ---
 	case tcell.KeyEnter:
+		if t.historyEnabled {
+			t.recordHistory(string(t.buffer))
+		}
 		// Trigger the onSubmit callback if it's set
 		if t.onSubmit != nil {
 			t.onSubmit(string(t.buffer))
@@ -2,6 +2,7 @@
 package tinytui
 
 import (
+	"slices"
 	"sync" // Use sync for thread-safe access to global theme manager
 )
 
@@ -28,6 +29,8 @@ type Theme interface {
 	TextStyle() Style
 	// TextSelectedStyle returns the style for selected text elements (e.g., in a future List component).
 	TextSelectedStyle() Style
+	// HighlightStyle returns the style for search matches and other highlighted spans (e.g., Grid.HighlightCells).
+	HighlightStyle() Style
 
 	// GridStyle returns the style for normal, unfocused grid cells.
 	GridStyle() Style
@@ -41,6 +44,8 @@ type Theme interface {
 	GridFocusedSelectedStyle() Style
 	// GridFocusedInteractedStyle returns the style for interacted grid cells when the grid has input focus.
 	GridFocusedInteractedStyle() Style
+	// GridHeaderStyle returns the style for the grid's column title row (see Grid.SetHeader).
+	GridHeaderStyle() Style
 
 	// PaneStyle returns the background style for the content area within panes (inside the border).
 	PaneStyle() Style
@@ -49,6 +54,18 @@ type Theme interface {
 	// PaneFocusBorderStyle returns the style for pane borders when the pane (or its children) has input focus.
 	PaneFocusBorderStyle() Style
 
+	// ScrollBarStyle returns the style for scrollbar tracks (e.g., Grid.SetScrollBar).
+	ScrollBarStyle() Style
+	// ScrollBarThumbStyle returns the style for scrollbar thumbs.
+	ScrollBarThumbStyle() Style
+	// FocusRingStyle returns the style for the outline drawn around the focused component (see FocusRingEnabled).
+	FocusRingStyle() Style
+	// ShadowStyle returns the style for pane drop shadows (see Pane.SetShadow). DefaultStyle darkens the
+	// colors of whatever is under the shadow instead of applying a fixed style.
+	ShadowStyle() Style
+	// SeparatorStyle returns the style for separator lines between components (e.g., the SplitPane divider).
+	SeparatorStyle() Style
+
 	// --- Property Getters ---
 
 	// DefaultCellWidth returns the theme's preferred default width for grid cells (used if Grid.autoWidth is false).
@@ -65,6 +82,9 @@ type Theme interface {
 	DefaultBorderType() Border
 	// FocusedBorderType returns the theme's preferred border type for panes when they (or their children) have focus.
 	FocusedBorderType() Border
+	// FocusRingEnabled reports whether a highlighted outline is drawn around the focused component
+	// (in FocusRingStyle), in addition to the focused pane border. See Application.SetFocusRing.
+	FocusRingEnabled() bool
 }
 
 // themeManager manages the set of available themes and the currently active global theme.
@@ -156,6 +176,37 @@ func GetTheme() Theme {
 	return globalThemeManager.current
 }
 
+// ThemeNames returns the names of all registered themes, sorted alphabetically.
+func ThemeNames() []ThemeName {
+	globalThemeManager.mu.RLock()
+	defer globalThemeManager.mu.RUnlock()
+
+	names := make([]ThemeName, 0, len(globalThemeManager.themes))
+	for name := range globalThemeManager.themes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NextTheme switches the global theme to the registered theme following the current one
+// (in ThemeNames order, wrapping around) and returns its name. Subscribers are notified as with
+// SetTheme; call app.SetTheme(GetTheme()) to restyle an application's component tree.
+func NextTheme() ThemeName {
+	names := ThemeNames()
+	if len(names) == 0 {
+		return ""
+	}
+	next := names[0]
+	if current := GetTheme(); current != nil {
+		if i := slices.Index(names, current.Name()); i >= 0 {
+			next = names[(i+1)%len(names)]
+		}
+	}
+	SetTheme(next)
+	return next
+}
+
 // SubscribeThemeChange registers a callback function to be executed whenever the global theme changes via SetTheme.
 // The callback is also executed immediately with the current theme upon successful registration.
 func SubscribeThemeChange(callback func(Theme)) {
@@ -196,6 +247,13 @@ func DefaultTextSelectedStyle() Style {
 	}
 	return t.TextSelectedStyle()
 }
+func DefaultHighlightStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle.Reverse(true).Underline(true)
+	}
+	return t.HighlightStyle()
+}
 func DefaultGridStyle() Style {
 	t := GetTheme()
 	if t == nil {
@@ -238,6 +296,13 @@ func DefaultGridFocusedInteractedStyle() Style {
 	}
 	return t.GridFocusedInteractedStyle()
 }
+func DefaultGridHeaderStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle.Bold(true)
+	}
+	return t.GridHeaderStyle()
+}
 func DefaultPaneStyle() Style {
 	t := GetTheme()
 	if t == nil {
@@ -259,6 +324,41 @@ func DefaultPaneFocusBorderStyle() Style {
 	}
 	return t.PaneFocusBorderStyle()
 }
+func DefaultScrollBarStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle
+	}
+	return t.ScrollBarStyle()
+}
+func DefaultScrollBarThumbStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle
+	}
+	return t.ScrollBarThumbStyle()
+}
+func DefaultFocusRingStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle.Reverse(true)
+	}
+	return t.FocusRingStyle()
+}
+func DefaultShadowStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle
+	}
+	return t.ShadowStyle()
+}
+func DefaultSeparatorStyle() Style {
+	t := GetTheme()
+	if t == nil {
+		return DefaultStyle
+	}
+	return t.SeparatorStyle()
+}
 func DefaultCellWidth() int {
 	t := GetTheme()
 	if t == nil {
@@ -301,6 +401,13 @@ func FocusedBorderType() Border {
 	}
 	return t.FocusedBorderType()
 }
+func FocusRingEnabled() bool {
+	t := GetTheme()
+	if t == nil {
+		return false
+	}
+	return t.FocusRingEnabled()
+}
 
 // GetGridStyle is a helper function to retrieve the appropriate style for a grid cell
 // based on its state (Normal, Selected, Interacted), whether the grid itself has focus,
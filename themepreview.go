@@ -0,0 +1,76 @@
+// themepreview.go
+package tinytui
+
+// BuildThemePreview builds a sample layout showing a representative set of components in their
+// various states (breadcrumb steps, buttons, a grid with selected, interacted and marked cells,
+// text with search highlights, a text input and a pane shadow), so theme authors can see a theme's effect at
+// a glance. Set it as the application's layout, or nest it in a pane, and switch themes with
+// app.SetTheme to restyle it. The components are created with the current global theme.
+func BuildThemePreview() *Layout {
+	// --- Steps: done, current and upcoming styles ---
+	steps := NewSteps()
+	steps.SetSteps([]string{"Choose", "Preview", "Apply"})
+	steps.SetCurrent(1)
+	stepsPane := NewPane()
+	stepsPane.SetBorder(BorderNone, DefaultPaneBorderStyle())
+	stepsPane.SetChild(steps)
+
+	// --- Buttons: normal and selected (focused) styles ---
+	buttons := NewButtonRow()
+	buttons.AddButton("OK", nil)
+	buttons.AddButton("Cancel", nil)
+	buttons.AddButton("Apply", nil)
+	buttonsPane := NewPane()
+	buttonsPane.SetTitle("Buttons")
+	buttonsPane.SetChild(buttons)
+
+	// --- Grid: header, normal, selected, interacted and marked cells ---
+	grid := NewGrid()
+	grid.SetHeader([]string{"One", "Two", "Three"})
+	grid.SetCells([][]string{
+		{"Normal", "Interacted", "Normal"},
+		{"Selected", "Normal", "Normal"},
+		{"Normal", "Normal", "Marked"},
+	})
+	grid.SetSelectionMode(MultiSelect)
+	grid.SetCellSize(12, 1)
+	grid.ImportState(GridState{SelectedRow: 1, SelectedCol: 0, Interacted: [][2]int{{0, 1}}})
+	grid.SetMarkedCell(2, 2)
+	gridPane := NewPane()
+	gridPane.SetTitle("Grid")
+	gridPane.SetChild(grid)
+	gridPane.SetShadow(true) // Drawn into the gap beside the text pane
+
+	// --- Text: plain and highlighted (search match) styles ---
+	text := NewText("Themes control the colors and borders of every component.\n" +
+		"Matches of a search are highlighted, and the current match stands out.\n" +
+		"Switch themes to compare them.")
+	text.SetWrap(true)
+	text.Search("theme")
+	textPane := NewPane()
+	textPane.SetTitle("Text")
+	textPane.SetChild(text)
+
+	// --- Input ---
+	input := NewTextInput()
+	input.SetText("Type here")
+	inputPane := NewPane()
+	inputPane.SetTitle("Input")
+	inputPane.SetChild(input)
+
+	// --- Assemble ---
+	middle := NewLayout(Horizontal)
+	middle.SetGap(1)
+	middle.AddPane(gridPane, Size{Proportion: 1})
+	middle.AddPane(textPane, Size{Proportion: 1})
+	middlePane := NewPane()
+	middlePane.SetBorder(BorderNone, DefaultPaneBorderStyle())
+	middlePane.SetChild(middle)
+
+	preview := NewLayout(Vertical)
+	preview.AddPane(stepsPane, Size{FixedSize: 1})
+	preview.AddPane(buttonsPane, Size{FixedSize: 3})
+	preview.AddPane(middlePane, Size{Proportion: 1})
+	preview.AddPane(inputPane, Size{FixedSize: 3})
+	return preview
+}
\ No newline at end of file
@@ -10,6 +10,11 @@ type Rect struct {
 	Height int
 }
 
+// contains reports whether the point (x, y) lies within the rectangle.
+func (r Rect) contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
 // Size defines constraints for how a component should be sized within a Layout.
 // Use either FixedSize (absolute cell count) or Proportion (relative share of remaining space).
 // If both are zero or negative, Layout typically assumes Proportion=1.
@@ -83,6 +88,32 @@ const (
 	ScreenAlternate
 )
 
+// RenderMode controls when the application redraws the screen.
+type RenderMode int
+
+const (
+	// RenderFixedTick polls for dirty components on a ticker at the maximum FPS (see SetMaxFPS) in addition
+	// to explicit redraw requests. Changes that only set a dirty flag are still picked up, at the cost of
+	// waking the CPU on every tick even when idle.
+	RenderFixedTick RenderMode = iota
+	// RenderOnDemand stops the ticker and redraws only when requested via QueueRedraw (which MarkDirty calls)
+	// or when an event or command leaves something dirty. Idle applications use no CPU, but anything changing
+	// state from another goroutine must go through Dispatch, AfterFunc or QueueRedraw to become visible.
+	RenderOnDemand
+)
+
+// EscapeAction controls what an Escape key press that no component handled does.
+type EscapeAction int
+
+const (
+	// EscapeQuit stops the application (the default).
+	EscapeQuit EscapeAction = iota
+	// EscapeBlur removes focus from the focused component instead of quitting. Escape with nothing focused is ignored.
+	EscapeBlur
+	// EscapeCustom calls the handler set with SetOnEscape (and does nothing if none is set).
+	EscapeCustom
+)
+
 // SelectionMode defines how selection and interaction behave within a Grid component.
 type SelectionMode int
 
@@ -92,4 +123,30 @@ const (
 	SingleSelect SelectionMode = iota
 	// MultiSelect allows multiple cells to be independently toggled into/out of the 'interacted' state.
 	MultiSelect
+)
+
+// SelectOnFocus defines what happens to a Grid's selection when the grid gains focus.
+type SelectOnFocus int
+
+const (
+	// SelectKeepPrevious leaves the current selection unchanged (default).
+	SelectKeepPrevious SelectOnFocus = iota
+	// SelectFirst moves the selection to the first cell.
+	SelectFirst
+	// SelectNone clears the selection; the first navigation key then selects the first cell.
+	SelectNone
+)
+
+// Animation selects how a Pane's content enters the screen when shown with Pane.AnimateShow.
+type Animation int
+
+const (
+	// AnimSlideLeft slides the content in from the right edge, moving left.
+	AnimSlideLeft Animation = iota
+	// AnimSlideRight slides the content in from the left edge, moving right.
+	AnimSlideRight
+	// AnimSlideUp slides the content in from the bottom edge, moving up.
+	AnimSlideUp
+	// AnimSlideDown slides the content in from the top edge, moving down.
+	AnimSlideDown
 )
\ No newline at end of file